@@ -0,0 +1,184 @@
+package cover
+
+import (
+	"math/bits"
+
+	"github.com/dkmccandless/bipartite"
+)
+
+// bitset is a set of non-negative integers.
+type bitset []uint64
+
+// newBitset returns an empty bitset that can hold the integers less than n.
+func newBitset(n int) bitset { return make(bitset, (n+63)/64) }
+
+// reuse returns an empty bitset that can hold the integers less than n,
+// reusing the memory of b if it is large enough.
+func (b bitset) reuse(n int) bitset {
+	w := (n + 63) / 64
+	if cap(b) < w {
+		return newBitset(n)
+	}
+	b = b[:w]
+	b.clear()
+	return b
+}
+
+// add adds i to b.
+func (b bitset) add(i int) { b[i/64] |= 1 << (i % 64) }
+
+// has reports whether b contains i.
+func (b bitset) has(i int) bool { return b[i/64]&(1<<(i%64)) != 0 }
+
+// clear removes all integers from b.
+func (b bitset) clear() {
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+// or adds the contents of a to b.
+func (b bitset) or(a bitset) {
+	for i := range b {
+		b[i] |= a[i]
+	}
+}
+
+// andNot removes the contents of a from b.
+func (b bitset) andNot(a bitset) {
+	for i := range b {
+		b[i] &^= a[i]
+	}
+}
+
+// intersects reports whether a and b have any integer in common.
+func (b bitset) intersects(a bitset) bool {
+	for i := range b {
+		if b[i]&a[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// equal reports whether a and b have the same contents.
+func (b bitset) equal(a bitset) bool {
+	for i := range b {
+		if b[i] != a[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// contains reports whether b contains every integer in a.
+func (b bitset) contains(a bitset) bool {
+	for i := range b {
+		if a[i]&^b[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// count returns the number of integers in b.
+func (b bitset) count() int {
+	var n int
+	for _, w := range b {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// next returns the least integer in b that is at least i, or -1 if there is none.
+func (b bitset) next(i int) int {
+	for k := i / 64; k < len(b); k++ {
+		w := b[k]
+		if k == i/64 {
+			w &= ^uint64(0) << (i % 64)
+		}
+		if w != 0 {
+			return k*64 + bits.TrailingZeros64(w)
+		}
+	}
+	return -1
+}
+
+// table indexes the Subsets and Elements of a graph so that the Elements of each Subset can be stored as a bitset.
+type table struct {
+	// ss and es hold the Subsets and Elements in index order.
+	ss []Subset
+	es []Element
+
+	// cov holds the indices of the Elements of each Subset.
+	cov []bitset
+
+	// adj holds the indices of the Subsets containing each Element.
+	adj [][]int
+
+	// all holds the indices of all Elements.
+	all bitset
+
+	// idx holds the index of each Element.
+	idx map[Element]int
+}
+
+// newTable returns a table of the Subsets in ss and the Elements of g that they contain.
+// The Subsets are indexed in the order of ss.
+func newTable(g *bipartite.Graph, ss []Subset) *table {
+	t := new(table)
+	t.reset(g, ss)
+	return t
+}
+
+// reset makes t a table of the Subsets in ss and the Elements of g that they contain,
+// as if by newTable, reusing the memory of t where possible.
+func (t *table) reset(g *bipartite.Graph, ss []Subset) {
+	t.ss = ss
+	t.es = t.es[:0]
+	if t.idx == nil {
+		t.idx = make(map[Element]int, g.NB())
+	} else {
+		clear(t.idx)
+	}
+	for _, s := range ss {
+		for _, e := range g.AdjToA(s) {
+			if _, ok := t.idx[e]; !ok {
+				t.idx[e] = len(t.es)
+				t.es = append(t.es, e)
+			}
+		}
+	}
+
+	t.adj = resize(t.adj, len(t.es))
+	for j := range t.adj {
+		t.adj[j] = t.adj[j][:0]
+	}
+	t.all = t.all.reuse(len(t.es))
+	t.cov = resize(t.cov, len(ss))
+	for i, s := range ss {
+		t.cov[i] = t.cov[i].reuse(len(t.es))
+		for _, e := range g.AdjToA(s) {
+			j := t.idx[e]
+			t.cov[i].add(j)
+			t.adj[j] = append(t.adj[j], i)
+		}
+	}
+	for j := range t.es {
+		t.all.add(j)
+	}
+}
+
+// resize returns a slice of length n that shares the memory of xs if it is large enough,
+// retaining the values of its elements up to its capacity.
+func resize[T any](xs []T, n int) []T {
+	if cap(xs) < n {
+		xs = append(xs[:cap(xs)], make([]T, n-cap(xs))...)
+	}
+	return xs[:n]
+}
+
+// dominates reports whether the Elements of t.ss[i] are a proper superset of those of t.ss[j].
+func (t *table) dominates(i, j int) bool {
+	return t.cov[i].contains(t.cov[j]) && !t.cov[j].contains(t.cov[i])
+}
@@ -0,0 +1,62 @@
+package cover
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBitset(t *testing.T) {
+	b := newBitset(130)
+	if len(b) != 3 {
+		t.Fatalf("newBitset(130): got %d words, want 3", len(b))
+	}
+	for _, i := range []int{0, 63, 64, 129} {
+		b.add(i)
+	}
+	var got []int
+	for i := b.next(0); i >= 0; i = b.next(i + 1) {
+		got = append(got, i)
+	}
+	if want := []int{0, 63, 64, 129}; !reflect.DeepEqual(got, want) {
+		t.Errorf("next: got %v, want %v", got, want)
+	}
+	if b.count() != 4 || !b.has(63) || b.has(65) {
+		t.Errorf("got count %d, has(63) %v, has(65) %v", b.count(), b.has(63), b.has(65))
+	}
+
+	a := newBitset(130)
+	a.add(64)
+	if !b.contains(a) || a.contains(b) || a.equal(b) {
+		t.Errorf("%v, %v: got contains %v, %v, equal %v", b, a, b.contains(a), a.contains(b), a.equal(b))
+	}
+	if !a.intersects(b) {
+		t.Errorf("intersects(%v, %v): got false", a, b)
+	}
+	a.or(b)
+	if !a.equal(b) {
+		t.Errorf("or: got %v, want %v", a, b)
+	}
+	a.andNot(b)
+	if a.count() != 0 || a.intersects(b) {
+		t.Errorf("andNot: got %v", a)
+	}
+	a.or(b)
+	a.clear()
+	if a.count() != 0 || a.next(0) != -1 {
+		t.Errorf("clear: got %v", a)
+	}
+}
+
+func TestTableDominates(t *testing.T) {
+	for name, test := range coverTests {
+		c := test.c
+		tab := newTable(c.m, subsets(c.m))
+		for i, d := range tab.ss {
+			for j, s := range tab.ss {
+				if got, want := tab.dominates(i, j), c.dominates(d, s); got != want {
+					t.Errorf("dominates(%v, %v, %v): got %v, want %v", name, d, s, got, want)
+				}
+			}
+		}
+	}
+}
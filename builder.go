@@ -0,0 +1,38 @@
+package cover
+
+// A Builder constructs a Cover by a chain of method calls, such as
+//
+//	c := new(cover.Builder).With("A", 1, 2).With("B", 2, 3).WithCost("C", 0.5, 3).Build()
+//
+// The zero value is an empty Builder ready to use.
+type Builder struct {
+	c *Cover
+}
+
+// With records that s contains es, as with Add, and returns b.
+func (b *Builder) With(s Subset, es ...Element) *Builder {
+	b.cover().Add(s, es...)
+	return b
+}
+
+// WithCost records that s contains es and has the given cost, as with AddWithCost, and returns b.
+func (b *Builder) WithCost(s Subset, cost float64, es ...Element) *Builder {
+	b.cover().AddWithCost(s, cost, es...)
+	return b
+}
+
+// Build returns the Cover of the Subsets and Elements recorded by b, and empties b
+// so that further calls begin a new Cover that shares no memory with the one returned.
+func (b *Builder) Build() *Cover {
+	c := b.cover()
+	b.c = nil
+	return c
+}
+
+// cover returns the Cover under construction, creating it if necessary.
+func (b *Builder) cover() *Cover {
+	if b.c == nil {
+		b.c = New()
+	}
+	return b.c
+}
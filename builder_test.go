@@ -0,0 +1,29 @@
+package cover
+
+import "testing"
+
+func TestBuilder(t *testing.T) {
+	var b Builder
+	c := b.With("A", 1, 2).With("B", 2, 3).WithCost("C", 0.5, 3).With("A", 4).With("D").Build()
+
+	want := New()
+	want.Add("A", 1, 2, 4)
+	want.Add("B", 2, 3)
+	want.AddWithCost("C", 0.5, 3)
+	if !c.Equal(want) {
+		t.Errorf("Build: got %v, want %v", c, want)
+	}
+	for s, cost := range map[Subset]float64{"A": 1, "B": 1, "C": 0.5} {
+		if got := c.Cost(s); got != cost {
+			t.Errorf("Build: got Cost(%v) %v, want %v", s, got, cost)
+		}
+	}
+
+	// Build empties the Builder.
+	if d := b.With("E", 5).Build(); d.NumSubsets() != 1 || c.NumSubsets() != 3 {
+		t.Errorf("Build after Build: got %v and %v, want 1 and 3 Subsets", d, c)
+	}
+	if got := new(Builder).Build(); !got.Equal(New()) {
+		t.Errorf("Build(empty): got %v, want empty Cover", got)
+	}
+}
@@ -0,0 +1,221 @@
+package cover
+
+import (
+	"slices"
+	"sort"
+)
+
+// Canonical returns a Cover isomorphic to c in which the Subsets are labeled by the ints from 0 to NumSubsets()-1
+// and the Elements by the ints from 0 to NumElements()-1, such that two Covers have Equal canonical forms
+// if and only if they are isomorphic: that is, if the Subsets and Elements of one can be relabeled
+// to make it Equal to the other. Costs given by AddWithCost are carried over and must also correspond.
+// Canonical does not modify c.
+//
+// Canonical orders the Subsets and Elements by color refinement, repeatedly distinguishing them
+// by the colors of the Elements and Subsets they contain or are contained by, starting from their costs.
+// When that leaves some indistinguishable, it tries distinguishing each in turn and keeps the labeling
+// whose incidences sort first. It skips those that the symmetries found so far show to be equivalent to one already tried,
+// but in the worst case it can take time exponential in the size of c.
+func (c *Cover) Canonical() *Cover {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	ss, es := subsets(c.in), elements(c.in)
+	sortBySprint(ss)
+	sortBySprint(es)
+
+	// Vertices 0 to n-1 are the Subsets, and the rest are the Elements.
+	n := len(ss)
+	idx := make(map[Element]int, len(es))
+	for j, e := range es {
+		idx[e] = n + j
+	}
+	k := &canon{n: n, adj: make([][]int, n+len(es))}
+	for i, s := range ss {
+		for _, e := range c.in.AdjToA(s) {
+			j := idx[e]
+			k.adj[i] = append(k.adj[i], j)
+			k.adj[j] = append(k.adj[j], i)
+		}
+	}
+	for _, a := range k.adj {
+		sort.Ints(a)
+	}
+
+	// Color Subsets before Elements, and Subsets in increasing order of cost.
+	keys := make([][]float64, len(k.adj))
+	for i := range keys {
+		if i < n {
+			keys[i] = []float64{0, c.costOf(ss[i])}
+		} else {
+			keys[i] = []float64{1, 0}
+		}
+	}
+	k.search(rank(keys), nil)
+
+	can := New()
+	for i, s := range ss {
+		for _, j := range k.adj[i] {
+			if cost, ok := c.cost[s]; ok {
+				can.addWithCost(k.best[i], cost, k.best[j]-n)
+			} else {
+				can.add(k.best[i], k.best[j]-n)
+			}
+		}
+	}
+	return can
+}
+
+// canon holds the state of a search for the canonical labeling of a bipartite graph.
+type canon struct {
+	// n is the number of Subsets, which are the vertices with indices less than n.
+	n int
+
+	// adj holds the sorted indices of the neighbors of each vertex.
+	adj [][]int
+
+	// best holds the labeling found so far whose edges sort first, and edges holds its sorted edges.
+	best  []int
+	edges []int
+
+	// auts holds automorphisms of the graph found by comparing labelings with the same edges.
+	auts [][]int
+}
+
+// search explores the labelings that refine colors and records the best of them.
+// path holds the vertices distinguished from the others of their colors to arrive at colors.
+func (k *canon) search(colors, path []int) {
+	colors = k.refine(colors)
+
+	// Find the first color shared by more than one vertex.
+	count := make([]int, len(colors))
+	for _, col := range colors {
+		count[col]++
+	}
+	cell := -1
+	for col, m := range count {
+		if m > 1 {
+			cell = col
+			break
+		}
+	}
+
+	if cell == -1 {
+		// Every vertex has a distinct color, which gives its label.
+		// Subsets are colored before Elements, so Elements' labels begin at n.
+		edges := make([]int, 0, len(colors))
+		for i := 0; i < k.n; i++ {
+			for _, j := range k.adj[i] {
+				edges = append(edges, colors[i]*len(colors)+colors[j])
+			}
+		}
+		sort.Ints(edges)
+		switch cmp := slices.Compare(edges, k.edges); {
+		case k.best == nil || cmp < 0:
+			k.best, k.edges = colors, edges
+		case cmp == 0:
+			// Mapping each vertex to the one with the same label in best preserves the edges.
+			byLabel := make([]int, len(colors))
+			for v, l := range k.best {
+				byLabel[l] = v
+			}
+			aut := make([]int, len(colors))
+			for v, l := range colors {
+				aut[v] = byLabel[l]
+			}
+			k.auts = append(k.auts, aut)
+		}
+		return
+	}
+
+	// Vertices with the same neighbors are interchangeable, and more generally, an automorphism that fixes
+	// every vertex of path maps the search below one vertex of the cell to an equivalent search below another,
+	// so only one vertex of each orbit of those automorphisms need be tried.
+	var tried []int
+	for v, col := range colors {
+		if col != cell || slices.ContainsFunc(tried, func(u int) bool { return slices.Equal(k.adj[u], k.adj[v]) || k.sameOrbit(u, v, path) }) {
+			continue
+		}
+		tried = append(tried, v)
+
+		// Distinguish v from the other vertices of its color.
+		keys := make([][]float64, len(colors))
+		for u, cu := range colors {
+			keys[u] = []float64{float64(cu), 0}
+			if cu == cell && u != v {
+				keys[u][1] = 1
+			}
+		}
+		k.search(rank(keys), append(path[:len(path):len(path)], v))
+	}
+}
+
+// sameOrbit reports whether the automorphisms found so far that fix every vertex of path
+// generate one that maps u to v.
+func (k *canon) sameOrbit(u, v int, path []int) bool {
+	orbit := make([]int, len(k.adj))
+	for w := range orbit {
+		orbit[w] = w
+	}
+	var find func(w int) int
+	find = func(w int) int {
+		if orbit[w] != w {
+			orbit[w] = find(orbit[w])
+		}
+		return orbit[w]
+	}
+	for _, aut := range k.auts {
+		if slices.ContainsFunc(path, func(p int) bool { return aut[p] != p }) {
+			continue
+		}
+		for w, x := range aut {
+			orbit[find(w)] = find(x)
+		}
+	}
+	return find(u) == find(v)
+}
+
+// refine returns the coarsest refinement of colors in which vertices of the same color
+// have the same number of neighbors of each color.
+func (k *canon) refine(colors []int) []int {
+	if len(colors) == 0 {
+		return colors
+	}
+	for {
+		keys := make([][]float64, len(colors))
+		for v, a := range k.adj {
+			key := make([]float64, 1, 1+len(a))
+			key[0] = float64(colors[v])
+			for _, u := range a {
+				key = append(key, float64(colors[u]))
+			}
+			slices.Sort(key[1:])
+			keys[v] = key
+		}
+		next := rank(keys)
+		// Colors are ranks, so the greatest is one less than the number of colors.
+		if slices.Max(next) == slices.Max(colors) {
+			return next
+		}
+		colors = next
+	}
+}
+
+// rank returns the rank of each key among the distinct keys, in lexicographic order.
+func rank(keys [][]float64) []int {
+	order := make([]int, len(keys))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return slices.Compare(keys[order[i]], keys[order[j]]) < 0 })
+	ranks := make([]int, len(keys))
+	for x, i := range order {
+		switch {
+		case x == 0:
+		case slices.Equal(keys[i], keys[order[x-1]]):
+			ranks[i] = ranks[order[x-1]]
+		default:
+			ranks[i] = ranks[order[x-1]] + 1
+		}
+	}
+	return ranks
+}
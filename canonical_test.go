@@ -0,0 +1,100 @@
+package cover
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// relabel returns a copy of c with its Subsets and Elements relabeled in a random order.
+func relabel(c *Cover, seed int64) *Cover {
+	r := rand.New(rand.NewSource(seed))
+	ss, es := subsets(c.in), elements(c.in)
+	sortBySprint(ss)
+	sortBySprint(es)
+	sp, ep := r.Perm(len(ss)), r.Perm(len(es))
+	sl := make(map[Subset]Subset, len(ss))
+	for i, s := range ss {
+		sl[s] = fmt.Sprintf("s%d", sp[i])
+	}
+	el := make(map[Element]Element, len(es))
+	for i, e := range es {
+		el[e] = fmt.Sprintf("e%d", ep[i])
+	}
+
+	d := New()
+	// Add the incidences in a random order too.
+	r.Shuffle(len(ss), func(i, j int) { ss[i], ss[j] = ss[j], ss[i] })
+	for _, s := range ss {
+		for _, e := range adjToA(c.in, s) {
+			if cost, ok := c.cost[s]; ok {
+				d.AddWithCost(sl[s], cost, el[e])
+			} else {
+				d.Add(sl[s], el[e])
+			}
+		}
+	}
+	return d
+}
+
+func TestCanonical(t *testing.T) {
+	covers := map[string]*Cover{
+		"empty":      New(),
+		"cycles":     cycles(3, 5),
+		"tilings":    tilings(4, 4, horizontalDomino, verticalDomino),
+		"duplicates": cycles(1, 4),
+	}
+	for i := 0; i < 8; i++ {
+		covers["duplicates"].Add(fmt.Sprint("dup", i), "0:0", "0:1")
+	}
+	for name, test := range coverTests {
+		covers[name] = test.c
+	}
+	for seed := int64(0); seed < 5; seed++ {
+		covers[fmt.Sprint("random ", seed)] = Generate(seed, 12, 12, 0.3)
+	}
+	weighted := New()
+	weighted.AddWithCost("A", 2, 1, 2)
+	weighted.AddWithCost("B", 1, 2, 3)
+	weighted.AddWithCost("C", 2, 3, 1)
+	covers["weighted"] = weighted
+
+	for name, c := range covers {
+		want := c.Canonical()
+		if want.NumSubsets() != c.NumSubsets() || want.NumElements() != c.NumElements() {
+			t.Errorf("Canonical(%v): got %d Subsets and %d Elements, want %d and %d",
+				name, want.NumSubsets(), want.NumElements(), c.NumSubsets(), c.NumElements())
+		}
+		if got := want.Canonical(); !got.Equal(want) {
+			t.Errorf("Canonical(Canonical(%v)): got %v, want %v", name, got, want)
+		}
+		for seed := int64(0); seed < 5; seed++ {
+			d := relabel(c, seed)
+			got := d.Canonical()
+			if !got.Equal(want) {
+				t.Errorf("Canonical(%v relabeled %d): got %v, want %v", name, seed, got, want)
+			}
+			for s := 0; s < want.NumSubsets(); s++ {
+				if got.Cost(s) != want.Cost(s) {
+					t.Errorf("Canonical(%v relabeled %d): got cost %v for %d, want %v", name, seed, got.Cost(s), s, want.Cost(s))
+				}
+			}
+		}
+	}
+
+	// Covers that are not isomorphic have different canonical forms.
+	a, b := New(), New()
+	a.Add("A", 1, 2)
+	a.Add("B", 2, 3)
+	a.Add("C", 3, 4)
+	b.Add("A", 1, 2)
+	b.Add("B", 1, 3)
+	b.Add("C", 1, 4)
+	if a.Canonical().Equal(b.Canonical()) {
+		t.Errorf("Canonical(%v) and Canonical(%v): got Equal", a, b)
+	}
+	weighted.AddWithCost("A", 1, 1)
+	if got := weighted.Canonical(); got.Cost(0)+got.Cost(1)+got.Cost(2) != 4 {
+		t.Errorf("Canonical(%v): got costs %v, %v, %v", weighted, got.Cost(0), got.Cost(1), got.Cost(2))
+	}
+}
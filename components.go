@@ -0,0 +1,60 @@
+package cover
+
+import "github.com/dkmccandless/bipartite"
+
+// searchComponents returns the same result as search, but searches each connected component of c.m separately.
+// No Subset contains Elements of two components, so a minimum covering set is the union of
+// a minimum covering set of each component, and the cost of the search is that of the largest component
+// rather than of their combination.
+func (c *Cover) searchComponents(ess []Subset) [][]Subset {
+	comps := components(c.m)
+	if len(comps) < 2 {
+		return c.search(ess)
+	}
+	covers := [][]Subset{ess}
+	for _, g := range comps {
+		sub := &Cover{in: g, m: g, stats: c.stats}
+		qs := sub.search(nil)
+		next := make([][]Subset, 0, len(covers)*len(qs))
+		for _, p := range covers {
+			for _, q := range qs {
+				cs := append(make([]Subset, 0, len(p)+len(q)), p...)
+				next = append(next, append(cs, q...))
+			}
+		}
+		covers = next
+	}
+	return covers
+}
+
+// components returns the connected components of g, each as a new graph.
+func components(g *bipartite.Graph) []*bipartite.Graph {
+	var comps []*bipartite.Graph
+	seen := make(sset, g.NA())
+	for _, s := range g.As() {
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		comp := bipartite.New()
+		queue := []Subset{s}
+		for len(queue) > 0 {
+			a := queue[0]
+			queue = queue[1:]
+			for _, e := range g.AdjToA(a) {
+				if comp.DegB(e) > 0 {
+					continue
+				}
+				for _, b := range g.AdjToB(e) {
+					comp.Add(b, e)
+					if _, ok := seen[b]; !ok {
+						seen[b] = struct{}{}
+						queue = append(queue, b)
+					}
+				}
+			}
+		}
+		comps = append(comps, comp)
+	}
+	return comps
+}
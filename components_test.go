@@ -0,0 +1,80 @@
+package cover
+
+import (
+	"fmt"
+	"testing"
+)
+
+// cycles returns a Cover of n disjoint cycles of k Subsets, in which Subset j of cycle i
+// contains Elements j and j+1 mod k of cycle i. Each cycle has k minimum covering sets
+// of (k+1)/2 Subsets if k is odd, and 2 of k/2 Subsets if k is even.
+func cycles(n, k int) *Cover {
+	c := New()
+	for i := 0; i < n; i++ {
+		for j := 0; j < k; j++ {
+			c.Add(fmt.Sprintf("%d.%d", i, j), fmt.Sprintf("%d:%d", i, j), fmt.Sprintf("%d:%d", i, (j+1)%k))
+		}
+	}
+	return c
+}
+
+func TestComponents(t *testing.T) {
+	for name, test := range coverTests {
+		var n int
+		for _, g := range components(test.c.in) {
+			for _, s := range g.As() {
+				for _, e := range g.AdjToA(s) {
+					if !test.c.in.Adjacent(s, e) {
+						t.Errorf("components(%v): %v does not contain %v", name, s, e)
+					}
+					n++
+				}
+			}
+		}
+		var want int
+		for _, s := range test.c.in.As() {
+			want += test.c.in.DegA(s)
+		}
+		if n != want {
+			t.Errorf("components(%v): got %d incidences, want %d", name, n, want)
+		}
+	}
+	if got := len(components(cycles(4, 5).in)); got != 4 {
+		t.Errorf("components(4 cycles): got %d components, want 4", got)
+	}
+}
+
+func TestSearchComponents(t *testing.T) {
+	// Three cycles of 5 Subsets and one of 3 have 5*5*5*3 minimum covering sets of 3+3+3+2 Subsets.
+	c := cycles(3, 5)
+	c.Add("A", "A:0", "A:1")
+	c.Add("B", "A:1", "A:2")
+	c.Add("C", "A:2", "A:0")
+	s, _ := c.simplified()
+	want := s.search(nil)
+	got := c.Minimize()
+	if len(got) != 5*5*5*3 || len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Minimize(disjoint cycles): got %d covering sets, want %d", len(got), len(want))
+	}
+	for _, cs := range got {
+		if len(cs) != 3+3+3+2 || !c.IsCover(cs) {
+			t.Errorf("Minimize(disjoint cycles): got %v, not a minimum covering set", cs)
+		}
+	}
+}
+
+func BenchmarkComponents(b *testing.B) {
+	noChecks(b)
+	c := cycles(4, 7)
+	s, _ := c.simplified()
+	b.Run("search", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s.search(nil)
+		}
+	})
+	b.Run("searchComponents", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s.searchComponents(nil)
+		}
+	})
+}
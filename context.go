@@ -0,0 +1,101 @@
+package cover
+
+import (
+	"context"
+	"errors"
+	"math"
+)
+
+// MinimizeContext is like Minimize but stops searching if ctx is done.
+// If it completes the search, it returns the same result as Minimize and a nil error.
+// Otherwise it returns the error of ctx and the best covering sets known when it stopped:
+// those of the shortest length found by the search so far, or if it found none,
+// the covering set found by the greedy heuristic of Greedy, which bounds the search.
+// These are valid covering sets but not necessarily minimum, so the result is only guaranteed
+// to be all of the minimum covering sets if the error is nil.
+//
+// MinimizeContext checks ctx periodically during the search, but not during simplification.
+func (c *Cover) MinimizeContext(ctx context.Context) ([][]Subset, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.minimizeLimited(func(b *bnb) {
+		b.ctx = ctx
+		b.err = ctx.Err()
+	})
+}
+
+// MinimizeBudget is like Minimize but stops searching once it has evaluated maxCombinations
+// partial selections of Subsets in its branch and bound search of the cyclic core.
+// It reports whether it completed the search, in which case it returns the same result as Minimize.
+// Otherwise it returns the best covering sets known when it stopped, as described for MinimizeContext,
+// which are valid covering sets but not necessarily minimum.
+//
+// Unlike a deadline, the budget limits the same work on every machine, so the result is reproducible.
+// Simplification is not counted toward it.
+func (c *Cover) MinimizeBudget(maxCombinations uint64) ([][]Subset, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	covers, err := c.minimizeLimited(func(b *bnb) {
+		if maxCombinations == 0 {
+			b.err = errBudget
+		} else if maxCombinations <= math.MaxInt {
+			b.maxNodes = int(maxCombinations)
+		}
+	})
+	return covers, err == nil
+}
+
+// MinimizeMaxWidth is like Minimize but gives up if a covering set would require more than maxWidth Subsets
+// in addition to the essential ones. If there is a covering set of that many or fewer, it returns the same result as Minimize
+// and true. Otherwise it returns nil and false, pruning every branch of the search that would exceed maxWidth.
+func (c *Cover) MinimizeMaxWidth(maxWidth int) ([][]Subset, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.prepare()
+	ess, isUnique := c.reduce()
+	switch {
+	case maxWidth < 0 || !isUnique && maxWidth == 0:
+		return nil, false
+	case isUnique:
+		return [][]Subset{ess}, true
+	}
+	b := newBnb(newTable(c.m, subsets(c.m)), ess)
+	b.best = min(maxWidth, b.best)
+	b.search()
+	return b.covers, b.covers != nil
+}
+
+// errBudget is the error of a bnb that has visited its maximum number of nodes.
+var errBudget = errors.New("cover: search budget exhausted")
+
+// minimizeLimited implements MinimizeContext and MinimizeBudget for a caller that holds c.mu.
+// It calls limit to configure the search of the cyclic core before it begins,
+// and does not search if limit sets the error of the bnb.
+func (c *Cover) minimizeLimited(limit func(b *bnb)) ([][]Subset, error) {
+	c.prepare()
+	if covers := c.minimizeFull(); covers != nil {
+		return covers, nil
+	}
+	ess, isUnique := c.reduce()
+	if isUnique {
+		return [][]Subset{ess}, nil
+	}
+
+	// Consider the Subsets in a fixed order so that the search is reproducible.
+	ss := subsets(c.m)
+	sortBySprint(ss)
+	b := newBnb(newTable(c.m, ss), ess)
+	g := greedy(b.t)
+	b.best = len(g)
+	if limit(b); b.err == nil {
+		b.search()
+	}
+	if b.err != nil && b.covers == nil {
+		cs := append(make([]Subset, 0, len(ess)+len(g)), ess...)
+		for _, i := range g {
+			cs = append(cs, b.t.ss[i])
+		}
+		b.covers = [][]Subset{cs}
+	}
+	return b.covers, b.err
+}
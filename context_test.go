@@ -0,0 +1,103 @@
+package cover
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestMinimizeContext(t *testing.T) {
+	for name, test := range coverTests {
+		got, err := test.c.Clone().MinimizeContext(context.Background())
+		if err != nil || len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("MinimizeContext(%v): got %v, %v; want %v, nil", name, got, err, test.min)
+		}
+	}
+
+	// A cancelled search returns valid covering sets that may not be minimum.
+	c := Generate(2, 60, 60, 0.1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	got, err := c.MinimizeContext(ctx)
+	if err != context.Canceled {
+		t.Fatalf("MinimizeContext(cancelled): got error %v, want %v", err, context.Canceled)
+	}
+	if len(got) == 0 {
+		t.Fatalf("MinimizeContext(cancelled): got no covering sets")
+	}
+	min := c.Clone().MinSize()
+	for _, cs := range got {
+		if !c.IsCover(cs) || len(cs) < min {
+			t.Errorf("MinimizeContext(cancelled): got %v, not a covering set of length at least %d", cs, min)
+		}
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	got, err = Generate(3, 200, 120, 0.05).MinimizeContext(ctx)
+	if err == nil {
+		t.Logf("MinimizeContext(timeout): search completed before deadline")
+	}
+	if len(got) == 0 {
+		t.Errorf("MinimizeContext(timeout): got no covering sets")
+	}
+}
+
+func TestMinimizeBudget(t *testing.T) {
+	for name, test := range coverTests {
+		got, ok := test.c.Clone().MinimizeBudget(math.MaxUint64)
+		if !ok || len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("MinimizeBudget(%v): got %v, %v; want %v, true", name, got, ok, test.min)
+		}
+	}
+
+	c := Generate(2, 60, 60, 0.1)
+	min := c.Clone().MinSize()
+	for _, budget := range []uint64{0, 1, 100} {
+		got, ok := c.Clone().MinimizeBudget(budget)
+		if ok {
+			t.Errorf("MinimizeBudget(%d): got true, want false", budget)
+		}
+		if len(got) == 0 {
+			t.Errorf("MinimizeBudget(%d): got no covering sets", budget)
+		}
+		for _, cs := range got {
+			if !c.IsCover(cs) || len(cs) < min {
+				t.Errorf("MinimizeBudget(%d): got %v, not a covering set of length at least %d", budget, cs, min)
+			}
+		}
+		if again, _ := c.Clone().MinimizeBudget(budget); len(again) != len(got) || !allMatch(again, got) {
+			t.Errorf("MinimizeBudget(%d): got %v, then %v", budget, got, again)
+		}
+	}
+
+	// A budget large enough for the search to complete gives the minimum covering sets.
+	c = Generate(1, 30, 20, 0.2)
+	want := c.Clone().Minimize()
+	got, ok := c.MinimizeBudget(1 << 20)
+	if !ok || len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("MinimizeBudget(1<<20): got %v, %v; want %v, true", got, ok, want)
+	}
+}
+
+func TestMinimizeMaxWidth(t *testing.T) {
+	for name, test := range coverTests {
+		// The width is the number of Subsets in each covering set that are not essential.
+		w := len(test.c.Clone().MinimizeCore()[0])
+		got, ok := test.c.Clone().MinimizeMaxWidth(w)
+		if !ok || len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("MinimizeMaxWidth(%v, %d): got %v, %v; want %v, true", name, w, got, ok, test.min)
+		}
+		if got, ok := test.c.Clone().MinimizeMaxWidth(w - 1); ok || got != nil {
+			t.Errorf("MinimizeMaxWidth(%v, %d): got %v, %v; want nil, false", name, w-1, got, ok)
+		}
+	}
+	for seed := int64(0); seed < 10; seed++ {
+		c := Generate(seed, 30, 20, 0.2)
+		want := c.Clone().Minimize()
+		if got, ok := c.MinimizeMaxWidth(10); !ok || len(got) != len(want) || !allMatch(got, want) {
+			t.Errorf("MinimizeMaxWidth(random %d, 10): got %v, %v; want %v, true", seed, got, ok, want)
+		}
+	}
+}
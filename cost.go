@@ -0,0 +1,131 @@
+package cover
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// MinCost returns the least total cost of any covering set, where the cost of each Subset
+// is given by AddWithCost, without generating the covering sets themselves. It does not modify c.
+// Costs must not be negative.
+//
+// Since a Subset of lower cost may be worth choosing even if another contains all of its Elements,
+// MinCost removes only the Subsets dominated by cost, as described for costDominated.
+// It then searches by branch and bound, covering the Element contained by the fewest Subsets
+// with each of them in turn. In the worst case it takes time exponential in the number of Subsets.
+func (c *Cover) MinCost() float64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.minCost()
+}
+
+// minCost implements MinCost for a caller that holds c.mu.
+func (c *Cover) minCost() float64 {
+	ss := subsets(c.in)
+	sortBySprint(ss)
+	t := newTable(c.in, ss)
+	costs := make([]float64, len(ss))
+	for i, s := range ss {
+		costs[i] = c.costOf(s)
+	}
+
+	// Replacing a Subset with one that dominates it by cost never increases the cost of a covering set.
+	removed := costDominated(t, costs)
+	for j, a := range t.adj {
+		t.adj[j] = slices.DeleteFunc(a, func(i int) bool { return removed[i] })
+	}
+
+	// cheapest holds the least cost of any Subset containing each Element.
+	cheapest := make([]float64, len(t.es))
+	for j, a := range t.adj {
+		cheapest[j] = math.Inf(1)
+		for _, i := range a {
+			cheapest[j] = min(cheapest[j], costs[i])
+		}
+		// Try the cheaper Subsets first to find a good bound sooner.
+		slices.SortStableFunc(a, func(i, k int) int { return cmp.Compare(costs[i], costs[k]) })
+	}
+
+	best := math.Inf(1)
+	if len(t.es) == 0 {
+		best = 0
+	}
+	var search func(covered bitset, cost float64)
+	search = func(covered bitset, cost float64) {
+		if covered.equal(t.all) {
+			best = min(best, cost)
+			return
+		}
+		// Every uncovered Element requires a Subset that costs at least its cheapest.
+		next, bound := -1, cost
+		for j := range t.es {
+			if covered.has(j) {
+				continue
+			}
+			bound = max(bound, cost+cheapest[j])
+			if next == -1 || len(t.adj[j]) < len(t.adj[next]) {
+				next = j
+			}
+		}
+		if bound >= best {
+			return
+		}
+		u := newBitset(len(t.es))
+		for _, i := range t.adj[next] {
+			if cost+costs[i] >= best {
+				// The remaining Subsets cost at least as much.
+				break
+			}
+			u.clear()
+			u.or(covered)
+			u.or(t.cov[i])
+			search(u, cost+costs[i])
+		}
+	}
+	search(newBitset(len(t.es)), 0)
+	return best
+}
+
+// costDominated reports which Subsets of t are dominated by cost, given the cost of each.
+// A Subset d dominates s by cost if d contains every Element of s and costs no more than s,
+// so that replacing s with d in a covering set does not increase its cost. Unlike domination by Elements alone,
+// a Subset that contains more Elements but costs more does not dominate. Of Subsets that contain the same Elements
+// and cost the same, only the one of lowest index is not dominated. A Subset is reported only if it is dominated
+// by one that is not, so removing every reported Subset leaves a covering set of least cost.
+func costDominated(t *table, costs []float64) []bool {
+	dominates := func(d, s int) bool {
+		if !t.cov[d].contains(t.cov[s]) || costs[d] > costs[s] {
+			return false
+		}
+		return d < s || costs[d] < costs[s] || !t.cov[s].contains(t.cov[d])
+	}
+	removed := make([]bool, len(t.ss))
+	for d := range t.ss {
+		if removed[d] {
+			continue
+		}
+		for s := range t.ss {
+			if d != s && !removed[s] && dominates(d, s) {
+				removed[s] = true
+			}
+		}
+	}
+	return removed
+}
+
+// IsMinimumCost reports whether cover is a covering set whose total cost, as given by AddWithCost,
+// is MinCost, allowing for rounding error in the sums. It does not modify c.
+func (c *Cover) IsMinimumCost(cover []Subset) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if len(c.covered(cover)) != c.in.NB() {
+		return false
+	}
+	var cost float64
+	for _, s := range cover {
+		cost += c.costOf(c.subset(s, false))
+	}
+	least := c.minCost()
+	return cost-least <= 1e-9*max(1, math.Abs(least))
+}
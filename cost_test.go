@@ -0,0 +1,115 @@
+package cover
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestMinCost(t *testing.T) {
+	// A contains every Element, but B and C cost less together.
+	c := New()
+	c.AddWithCost("A", 5, 1, 2, 3, 4)
+	c.AddWithCost("B", 2, 1, 2)
+	c.AddWithCost("C", 2, 3, 4)
+	c.AddWithCost("D", 0.5, 4)
+	if got := c.MinCost(); got != 4 {
+		t.Errorf("MinCost(%v): got %v, want 4", c, got)
+	}
+	for _, tc := range []struct {
+		cover []Subset
+		want  bool
+	}{
+		{[]Subset{"B", "C"}, true},
+		{[]Subset{"C", "B"}, true},
+		{[]Subset{"A"}, false},
+		{[]Subset{"B", "C", "D"}, false},
+		{[]Subset{"B", "D"}, false},
+	} {
+		if got := c.IsMinimumCost(tc.cover); got != tc.want {
+			t.Errorf("IsMinimumCost(%v, %v): got %v, want %v", c, tc.cover, got, tc.want)
+		}
+	}
+	// A covering set of minimum length need not have minimum cost.
+	if !c.IsMinimum([]Subset{"A"}) || c.IsMinimum([]Subset{"B", "C"}) {
+		t.Errorf("IsMinimum(%v): want true for [A] and false for [B C]", c)
+	}
+
+	if got := New().MinCost(); got != 0 {
+		t.Errorf("MinCost(empty): got %v, want 0", got)
+	}
+
+	// With unit costs, MinCost is MinSize.
+	for name, test := range coverTests {
+		if got, want := test.c.MinCost(), float64(test.c.MinSize()); got != want {
+			t.Errorf("MinCost(%v): got %v, want %v", name, got, want)
+		}
+	}
+
+	// Compare with the least cost of every combination of Subsets.
+	for seed := int64(0); seed < 50; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		g := Generate(seed, 10, 10, 0.3)
+		c := New()
+		ss := subsets(g.in)
+		for _, s := range ss {
+			c.AddWithCost(s, float64(r.Intn(10)), adjToA(g.in, s)...)
+		}
+		want := math.Inf(1)
+		for bits := 0; bits < 1<<len(ss); bits++ {
+			var cs []Subset
+			var cost float64
+			for i, s := range ss {
+				if bits&(1<<i) != 0 {
+					cs = append(cs, s)
+					cost += c.Cost(s)
+				}
+			}
+			if cost < want && c.IsCover(cs) {
+				want = cost
+			}
+		}
+		if got := c.MinCost(); got != want {
+			t.Errorf("MinCost(seed %d): got %v, want %v", seed, got, want)
+		}
+	}
+}
+
+func TestCostDominated(t *testing.T) {
+	for _, test := range []struct {
+		costs []float64
+		want  []bool
+	}{
+		// B contains every Element of A and C but costs more, so it dominates neither.
+		// C and D contain the same Elements and cost the same, so only D is removed.
+		{[]float64{1, 5, 1, 1}, []bool{false, false, false, true}},
+		// B costs no more than any other, so it dominates them all.
+		{[]float64{1, 1, 1, 1}, []bool{true, false, true, true}},
+		// The cheaper of C and D dominates the other.
+		{[]float64{1, 5, 2, 1}, []bool{false, false, true, false}},
+		{[]float64{1, 5, 0.5, 1}, []bool{false, false, false, true}},
+	} {
+		c := New()
+		c.AddWithCost("A", test.costs[0], 1, 2)
+		c.AddWithCost("B", test.costs[1], 1, 2, 3)
+		c.AddWithCost("C", test.costs[2], 3)
+		c.AddWithCost("D", test.costs[3], 3)
+		ss := []Subset{"A", "B", "C", "D"}
+		if got := costDominated(newTable(c.in, ss), test.costs); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("costDominated(%v): got %v, want %v", test.costs, got, test.want)
+		}
+	}
+
+	// B is larger but more expensive, and the least cost requires A.
+	c := New()
+	c.AddWithCost("A", 1, 1, 2)
+	c.AddWithCost("B", 5, 1, 2, 3)
+	c.AddWithCost("C", 1, 3)
+	if got := c.MinCost(); got != 2 {
+		t.Errorf("MinCost(%v): got %v, want 2", c, got)
+	}
+	if !c.IsMinimumCost([]Subset{"A", "C"}) || c.IsMinimumCost([]Subset{"B"}) {
+		t.Errorf("IsMinimumCost(%v): want true for [A C] and false for [B]", c)
+	}
+}
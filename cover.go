@@ -2,7 +2,18 @@
 package cover
 
 import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"iter"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
 	"sort"
+	"strings"
+	"sync"
 
 	"github.com/dkmccandless/bipartite"
 )
@@ -38,7 +49,20 @@ func (ss sset) copy() sset {
 }
 
 // Cover records Subsets and the Elements they contain.
+//
+// A Cover is safe for concurrent use by multiple goroutines.
+// Methods that modify it, such as Add, Merge, and Minimize, hold an exclusive lock for their duration,
+// and all other methods hold a shared lock, so that each call observes the Cover
+// as it was before or after any concurrent modification and never partway through one.
+// In particular, Minimize copies the Subsets and Elements added so far and simplifies them
+// with Add blocked until it returns, so its result reflects a single consistent state.
+// The iterator returned by MinimizeSeq takes a snapshot of c each time it is used,
+// and later changes to c do not affect an iteration in progress.
+// Calls made by separate goroutines are serialized in an unspecified order.
 type Cover struct {
+	// mu guards the fields below.
+	mu sync.RWMutex
+
 	// in stores all added Subsets and Elements.
 	// Minimize copies their contents into m to modify.
 	in *bipartite.Graph
@@ -50,6 +74,38 @@ type Cover struct {
 
 	// essential contains the Subsets determined by Minimize to be necessary members of the covering set.
 	essential sset
+
+	// cost holds the costs of Subsets added by AddWithCost. Other Subsets cost 1.
+	// It is nil if AddWithCost has not been called.
+	cost map[Subset]float64
+
+	// stats, if not nil, accumulates statistics about a call to Minimize in progress.
+	stats *Stats
+
+	// trace, if not nil, receives a description of each step of a call to Minimize in progress.
+	trace io.Writer
+
+	// tab, if not nil, is reused by reduceS and reduceC to hold the table of Subsets in c.m.
+	tab *table
+
+	// subsetKey and elementKey, if not nil, return the keys that identify Subsets and Elements.
+	subsetKey  func(Subset) interface{}
+	elementKey func(Element) interface{}
+
+	// subsetRep and elementRep hold the first Subset and Element added with each key.
+	// They are nil if the corresponding key function is nil.
+	subsetRep  map[interface{}]Subset
+	elementRep map[interface{}]Element
+
+	// meta holds the metadata of Subsets given by SetMeta, indexed by metaKey. It is nil if SetMeta has not been called.
+	meta map[interface{}]interface{}
+
+	// dontCare holds the don't-care Elements of Subsets given by AddDontCare.
+	// It is nil if AddDontCare has not been called.
+	dontCare *dontCares
+
+	// last, if not nil, records the result of the most recent call to MinimizeIncremental.
+	last *snapshot
 }
 
 // New returns an empty Cover.
@@ -62,86 +118,1533 @@ func New() *Cover {
 	}
 }
 
+// Clone returns a copy of c that shares no memory with it.
+func (c *Cover) Clone() *Cover {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	cp := &Cover{
+		in: bipartite.Copy(c.in),
+		m:  bipartite.Copy(c.m),
+
+		essential: c.essential.copy(),
+
+		cost: maps.Clone(c.cost),
+		meta: maps.Clone(c.meta),
+
+		subsetKey:  c.subsetKey,
+		elementKey: c.elementKey,
+		subsetRep:  maps.Clone(c.subsetRep),
+		elementRep: maps.Clone(c.elementRep),
+	}
+	if c.dontCare != nil {
+		cp.dontCare = c.dontCare.copy()
+	}
+	return cp
+}
+
+// Reset empties c in place, leaving it equivalent to a Cover newly returned by New,
+// or by NewWithKeys with the same key functions, while retaining its allocated storage where possible for reuse.
+func (c *Cover) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	empty(c.in)
+	empty(c.m)
+	clear(c.essential)
+	clear(c.cost)
+	clear(c.meta)
+	clear(c.subsetRep)
+	clear(c.elementRep)
+	c.dontCare = nil
+	c.last = nil
+}
+
 // Add records that s contains es.
 // If es is empty, Add is a no-op.
 func (c *Cover) Add(s Subset, es ...Element) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.add(s, es...)
+}
+
+// AddReport records that s contains es, as with Add, and returns the number of
+// incidences of s with an Element of es that had not already been recorded.
+// If it returns 0, c is unchanged, and the results of a previous call to Minimize still apply.
+func (c *Cover) AddReport(s Subset, es ...Element) (newEdges int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.add(s, es...)
+}
+
+// add implements Add and AddReport for a caller that holds c.mu.
+func (c *Cover) add(s Subset, es ...Element) int {
+	if len(es) == 0 {
+		return 0
+	}
+	s = c.subset(s, true)
+	var n int
 	for _, e := range es {
-		c.in.Add(s, e)
+		e = c.element(e, true)
+		if !c.in.Adjacent(s, e) {
+			c.in.Add(s, e)
+			n++
+		}
+	}
+	return n
+}
+
+// AddWithCost records that s contains es, as with Add, and that s has the given cost,
+// replacing any cost previously given for it. Subsets not added by AddWithCost cost 1.
+// Costs are used only by methods that say so; Minimize minimizes the number of Subsets.
+// If es is empty, AddWithCost is a no-op.
+func (c *Cover) AddWithCost(s Subset, cost float64, es ...Element) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.addWithCost(s, cost, es...)
+}
+
+// addWithCost implements AddWithCost for a caller that holds c.mu.
+func (c *Cover) addWithCost(s Subset, cost float64, es ...Element) {
+	if len(es) == 0 {
+		return
 	}
+	c.add(s, es...)
+	if c.cost == nil {
+		c.cost = make(map[Subset]float64)
+	}
+	c.cost[c.subset(s, false)] = cost
+}
+
+// Cost returns the cost of s given by AddWithCost, or 1 if none was given.
+func (c *Cover) Cost(s Subset) float64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.costOf(c.subset(s, false))
+}
+
+// costOf implements Cost for a caller that holds c.mu.
+func (c *Cover) costOf(s Subset) float64 {
+	if cost, ok := c.cost[s]; ok {
+		return cost
+	}
+	return 1
+}
+
+// AddDontCare records that s contains the don't-care Elements es: Elements that need not be covered,
+// as for the don't-care inputs of a Boolean function. Don't-care Elements are not added to c as Elements,
+// so they are never required to be covered, and no Subset is essential because it contains one.
+// A Subset that contains only don't-care Elements is not added to c.
+//
+// Don't-care Elements affect only the removal of dominated Subsets, and only between Subsets
+// that contain exactly the same Elements. Either of two such Subsets serves equally well in a covering set,
+// so if the don't-care Elements of one are a proper superset of those of the other, it dominates the other,
+// and Minimize prefers it. Subsets that contain different Elements are compared by their Elements alone,
+// regardless of their don't-care Elements.
+// If es is empty, AddDontCare is a no-op.
+func (c *Cover) AddDontCare(s Subset, es ...Element) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(es) == 0 {
+		return
+	}
+	if c.dontCare == nil {
+		c.dontCare = &dontCares{g: bipartite.New(), key: c.subsetKey}
+	}
+	// AddDontCare does not add s or es, so it must not choose their representatives.
+	for _, e := range es {
+		c.dontCare.add(s, c.element(e, false))
+	}
+	// Domination among the Subsets already added may have changed.
+	c.last = nil
+}
+
+// SetMeta associates meta with s, replacing any metadata previously associated with it,
+// or removes it if meta is nil. Metadata is not used by any method of c;
+// it allows the caller to look up information about the Subsets of a covering set with Meta.
+// s need not have been added to c, and its metadata is kept if it is not.
+func (c *Cover) SetMeta(s Subset, meta interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	k := c.metaKey(s)
+	if meta == nil {
+		delete(c.meta, k)
+		return
+	}
+	if c.meta == nil {
+		c.meta = make(map[interface{}]interface{})
+	}
+	c.meta[k] = meta
+}
+
+// Meta returns the metadata associated with s by SetMeta, or nil if there is none.
+func (c *Cover) Meta(s Subset) interface{} {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.meta[c.metaKey(s)]
+}
+
+// metaKey returns the key of the metadata of s in c.meta: the key of s if c has a Subset key function,
+// so that metadata set before s is added applies to whichever Subset with that key represents it, or s itself otherwise.
+// SetMeta does not add s, so it must not choose the representative.
+func (c *Cover) metaKey(s Subset) interface{} {
+	if c.subsetKey == nil {
+		return s
+	}
+	return c.subsetKey(s)
+}
+
+// AddSet records that s contains the keys of es.
+// If es is empty, AddSet is a no-op.
+func (c *Cover) AddSet(s Subset, es map[Element]struct{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for e := range es {
+		c.add(s, e)
+	}
+}
+
+// Merge records that each Subset added to other contains the Elements it contains in other,
+// as if by calling Add for each of them, or AddWithCost for those with costs in other.
+// Subsets added to both Covers contain the union of their Elements.
+// The results of any previous call to Minimize on other are ignored.
+// Merging a Cover into itself has no effect.
+func (c *Cover) Merge(other *Cover) {
+	if other == c {
+		return
+	}
+	other.mu.RLock()
+	ins := other.incidences()
+	other.mu.RUnlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, in := range ins {
+		c.addIncidence(in)
+	}
+}
+
+// SplitSubset replaces s with the Subsets a and b, so that a contains each Element of s for which inA returns true
+// and b contains the rest, as if by Add. Don't-care Elements of s given by AddDontCare are divided the same way.
+// If a or b has already been added, its Elements are added to those it contains;
+// if it receives no Elements, it is not added and receives no don't-care Elements. Any cost given for s is discarded.
+// SplitSubset discards the results of any previous call to Minimize, so Essential returns nil until it is called again.
+// If s was not added to c, SplitSubset is a no-op.
+// inA is called without c locked, so it may call methods of c such as Frequency.
+func (c *Cover) SplitSubset(s Subset, a, b Subset, inA func(Element) bool) {
+	// Evaluate inA for each Element of s, including any added while c was unlocked.
+	in := make(map[Element]bool)
+	for {
+		c.mu.Lock()
+		s = c.subset(s, false)
+		var todo []Element
+		for _, e := range c.in.AdjToA(s) {
+			if _, ok := in[e]; !ok {
+				todo = append(todo, e)
+			}
+		}
+		if c.dontCare != nil && c.in.DegA(s) > 0 {
+			for _, e := range c.dontCare.elements(s) {
+				if _, ok := in[e]; !ok {
+					todo = append(todo, e)
+				}
+			}
+		}
+		if todo == nil {
+			break
+		}
+		c.mu.Unlock()
+		for _, e := range todo {
+			in[e] = inA(e)
+		}
+	}
+	defer c.mu.Unlock()
+	if c.in.DegA(s) == 0 {
+		return
+	}
+
+	var ea, eb []Element
+	for _, e := range c.in.AdjToA(s) {
+		if in[e] {
+			ea = append(ea, e)
+		} else {
+			eb = append(eb, e)
+		}
+	}
+	c.in.RemoveA(s)
+	delete(c.cost, s)
+	c.add(a, ea...)
+	c.add(b, eb...)
+	if c.dontCare != nil {
+		for _, e := range c.dontCare.remove(s) {
+			if in[e] && len(ea) > 0 {
+				c.dontCare.add(a, e)
+			} else if !in[e] && len(eb) > 0 {
+				c.dontCare.add(b, e)
+			}
+		}
+	}
+	c.forgetSubset(s)
+
+	c.discardResults()
+}
+
+// MergeSubsets replaces a and b with the Subset merged, which contains every Element of either, as if by Add.
+// Don't-care Elements of a and b given by AddDontCare are combined the same way.
+// A Subset that was not added to c contains no Elements. If merged has already been added and is neither a nor b,
+// the Elements of a and b are added to those it contains. Any costs given for a and b are discarded.
+// Like SplitSubset, MergeSubsets discards the results of any previous call to Minimize.
+func (c *Cover) MergeSubsets(a, b, merged Subset) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	a, b = c.subset(a, false), c.subset(b, false)
+	remove := func(g *bipartite.Graph) []Element {
+		es := adjToA(g, a)
+		if b != a {
+			es = append(es, adjToA(g, b)...)
+		}
+		g.RemoveA(a)
+		g.RemoveA(b)
+		return es
+	}
+
+	es := remove(c.in)
+	delete(c.cost, a)
+	delete(c.cost, b)
+	c.add(merged, es...)
+	if c.dontCare != nil {
+		es := c.dontCare.remove(a)
+		if b != a {
+			es = append(es, c.dontCare.remove(b)...)
+		}
+		for _, e := range es {
+			c.dontCare.add(merged, e)
+		}
+	}
+	c.forgetSubset(a)
+	c.forgetSubset(b)
+	c.discardResults()
+}
+
+// discardResults discards the results of any previous call to Minimize or MinimizeIncremental
+// after a change to c that may invalidate them.
+func (c *Cover) discardResults() {
+	c.m = bipartite.New()
+	c.essential = make(sset)
+	c.last = nil
+}
+
+// Equal reports whether c and other have the same Subsets containing the same Elements,
+// regardless of the order in which they were added.
+// It does not compare costs or the results of any call to Minimize.
+func (c *Cover) Equal(other *Cover) bool {
+	if other == c {
+		return true
+	}
+	other.mu.RLock()
+	o := bipartite.Copy(other.in)
+	other.mu.RUnlock()
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.in.NA() != o.NA() || c.in.NB() != o.NB() {
+		return false
+	}
+	for _, s := range c.in.As() {
+		if c.in.DegA(s) != o.DegA(s) {
+			return false
+		}
+		for _, e := range c.in.AdjToA(s) {
+			if !o.Adjacent(s, e) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Hash returns a 64-bit FNV-1a hash of the Subsets added to c and the Elements they contain,
+// which depends only on their fmt.Sprint representations and not on the order in which they were added.
+// Covers for which Equal reports true have the same Hash, and so, for example,
+// do Covers that differ only in Subsets or Elements with the same representation, such as 1 and "1".
+// Hash does not depend on costs or on the results of any call to Minimize, so it may be computed before Minimize.
+func (c *Cover) Hash() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	h := fnv.New64a()
+	// Prefix each variable-length item with its length so that distinct incidences hash distinctly.
+	write := func(x interface{}) {
+		str := fmt.Sprint(x)
+		fmt.Fprintf(h, "%d:%s", len(str), str)
+	}
+	for _, in := range c.incidences() {
+		write(in.Subset)
+		fmt.Fprintf(h, "%d;", len(in.Elements))
+		for _, e := range in.Elements {
+			write(e)
+		}
+	}
+	return h.Sum64()
+}
+
+// NumSubsets returns the number of Subsets added to c.
+func (c *Cover) NumSubsets() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.in.NA()
+}
+
+// NumElements returns the number of Elements added to c.
+// The cost of Minimize generally grows exponentially with it.
+func (c *Cover) NumElements() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.in.NB()
+}
+
+// Universe returns the set of Elements added to c.
+// The map is newly allocated, and the caller may modify it.
+func (c *Cover) Universe() map[Element]struct{} {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	u := make(map[Element]struct{}, c.in.NB())
+	for _, e := range c.in.Bs() {
+		u[e] = struct{}{}
+	}
+	return u
+}
+
+// Size returns the number of Elements that s contains, or 0 if s was not added to c.
+// It counts every Element added to s, regardless of any call to Minimize.
+func (c *Cover) Size(s Subset) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.in.DegA(c.subset(s, false))
+}
+
+// Frequency returns the number of Subsets that contain e, or 0 if e was not added to c.
+// Like Size, it counts every Subset added to c, regardless of any call to Minimize.
+// Minimize finds a Subset to be essential if it is the only one to contain some Element,
+// so each Subset that contains an Element of frequency 1 is essential.
+func (c *Cover) Frequency(e Element) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.in.DegB(c.element(e, false))
+}
+
+// ElementIndex returns the Subsets that contain each Element added to c, sorted by their fmt.Sprint representations.
+// Like Frequency, it counts every Subset added to c, regardless of any call to Minimize.
+// The map and its slices are newly allocated, so the caller may modify them.
+func (c *Cover) ElementIndex() map[Element][]Subset {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	idx := make(map[Element][]Subset, c.in.NB())
+	for _, e := range c.in.Bs() {
+		ss := make([]Subset, 0, c.in.DegB(e))
+		for _, s := range c.in.AdjToB(e) {
+			ss = append(ss, s)
+		}
+		sortBySprint(ss)
+		idx[e] = ss
+	}
+	return idx
+}
+
+// ElementDegreeHistogram returns the number of Elements of each frequency: the number of Subsets that contain them.
+// Elements of frequency 1 make the Subsets that contain them essential, and are handled quickly by simplification,
+// while the number of combinations of Subsets that Minimize may need to search grows with the frequencies of the rest.
+// The map is newly allocated and contains no zero counts.
+func (c *Cover) ElementDegreeHistogram() map[int]int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	h := make(map[int]int)
+	for _, e := range c.in.Bs() {
+		h[c.in.DegB(e)]++
+	}
+	return h
+}
+
+// EssentialElements returns the Elements of frequency 1, each of which is contained by only one Subset,
+// sorted by their fmt.Sprint representations. Each such Subset must belong to every covering set,
+// and Minimize finds it to be essential. Unlike Essential, EssentialElements considers only the Subsets
+// and Elements added to c, not those found essential after the removal of dominated Subsets or Elements,
+// so it does not depend on any call to Minimize.
+func (c *Cover) EssentialElements() []Element {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var es []Element
+	for _, e := range c.in.Bs() {
+		if c.in.DegB(e) == 1 {
+			es = append(es, e)
+		}
+	}
+	sortBySprint(es)
+	return es
+}
+
+// Duplicates returns the groups of two or more Subsets that contain identical Elements.
+// Since neither of two such Subsets dominates the other, Minimize returns
+// a separate covering set for each Subset of a group that belongs to one.
+// Groups and the Subsets within them are sorted by their fmt.Sprint representations.
+func (c *Cover) Duplicates() [][]Subset {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	ss := subsets(c.in)
+	sortBySprint(ss)
+	t := newTable(c.in, ss)
+	idx := make(map[string]int)
+	var groups [][]Subset
+	for i, s := range ss {
+		k := fmt.Sprint(t.cov[i])
+		j, ok := idx[k]
+		if !ok {
+			j = len(groups)
+			idx[k] = j
+			groups = append(groups, nil)
+		}
+		groups[j] = append(groups[j], s)
+	}
+
+	var dups [][]Subset
+	for _, g := range groups {
+		if len(g) > 1 {
+			dups = append(dups, g)
+		}
+	}
+	return dups
+}
+
+// MergeEquivalentElements removes from c all but one of each group of Elements that are contained by the same Subsets,
+// keeping the Element that sorts first by its fmt.Sprint representation, and returns the number removed.
+// Every covering set that covers the remaining Element of a group covers the others,
+// so the covering sets returned by Minimize are unchanged, but there are fewer Elements to consider.
+func (c *Cover) MergeEquivalentElements() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return mergeElements(c.in)
+}
+
+// mergeElements implements MergeEquivalentElements on g.
+func mergeElements(g *bipartite.Graph) int {
+	ss := subsets(g)
+	sortBySprint(ss)
+	idx := make(map[Subset]int, len(ss))
+	for i, s := range ss {
+		idx[s] = i
+	}
+	es := elements(g)
+	sortBySprint(es)
+
+	var n int
+	seen := make(map[string]bool)
+	sig := make([]int, 0, len(ss))
+	for _, e := range es {
+		sig = sig[:0]
+		for _, s := range g.AdjToB(e) {
+			sig = append(sig, idx[s])
+		}
+		sort.Ints(sig)
+		if k := fmt.Sprint(sig); seen[k] {
+			g.RemoveB(e)
+			n++
+		} else {
+			seen[k] = true
+		}
+	}
+	return n
+}
+
+// Dominated returns the Subsets whose Elements are a proper subset of those of s,
+// or that contain the same Elements and a proper subset of its don't-care Elements as described for AddDontCare,
+// sorted by their fmt.Sprint representations. Minimize removes such Subsets when it simplifies c,
+// but Dominated considers every Subset and Element added to c, regardless of any call to Minimize.
+// It returns nil if s was not added to c.
+func (c *Cover) Dominated(s Subset) []Subset { return c.dominance(s, false) }
+
+// Dominators returns the Subsets that dominate s as described for Dominated,
+// sorted by their fmt.Sprint representations. Like Dominated, it considers every Subset and Element added to c.
+// It returns nil if s was not added to c.
+func (c *Cover) Dominators(s Subset) []Subset { return c.dominance(s, true) }
+
+// Maximal returns the Subsets that no other Subset dominates, that is, those for which Dominators returns nil,
+// sorted by their fmt.Sprint representations. Subsets that contain the same Elements are all returned
+// unless one has more don't-care Elements than another.
+// When the Subsets are the implicants of a Boolean function, the maximal ones are its prime implicants.
+// Like Dominated, Maximal considers every Subset and Element added to c, and it does not modify c.
+func (c *Cover) Maximal() []Subset {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	ss := subsets(c.in)
+	sortBySprint(ss)
+	t := newTable(c.in, ss)
+	var ms []Subset
+	for i := range ss {
+		// A Subset that dominates ss[i] contains each of its Elements, so only those containing one need be checked.
+		if !slices.ContainsFunc(t.adj[t.cov[i].next(0)], func(j int) bool { return c.dominatesIn(t, j, i) }) {
+			ms = append(ms, ss[i])
+		}
+	}
+	return ms
+}
+
+// dominance implements Dominated and, if up is true, Dominators.
+func (c *Cover) dominance(s Subset, up bool) []Subset {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s = c.subset(s, false)
+	if c.in.DegA(s) == 0 {
+		return nil
+	}
+	ss := subsets(c.in)
+	sortBySprint(ss)
+	t := newTable(c.in, ss)
+	i := slices.Index(ss, s)
+	var ds []Subset
+	for j := range ss {
+		if up && c.dominatesIn(t, j, i) || !up && c.dominatesIn(t, i, j) {
+			ds = append(ds, ss[j])
+		}
+	}
+	return ds
+}
+
+// Similarity returns the Jaccard similarity of the Elements of a and b: the number of Elements they both contain
+// divided by the number contained by either. It is 1 if they contain the same Elements and 0 if they have none in common.
+// A Subset not added to c contains no Elements, so Similarity returns 0 if neither a nor b was added.
+func (c *Cover) Similarity(a, b Subset) float64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	a, b = c.subset(a, false), c.subset(b, false)
+	var both int
+	for _, e := range c.in.AdjToA(a) {
+		if c.in.Adjacent(b, e) {
+			both++
+		}
+	}
+	either := c.in.DegA(a) + c.in.DegA(b) - both
+	if either == 0 {
+		return 0
+	}
+	return float64(both) / float64(either)
+}
+
+// Overlaps returns the number of Elements contained by both Subsets of each pair that have any in common.
+// Each pair appears once, ordered by the fmt.Sprint representations of its Subsets.
+// Overlaps considers every Subset and Element added to c, regardless of any call to Minimize.
+// It takes time proportional to the sum over Elements of the square of the number of Subsets
+// that contain each one, which is at most the number of Subsets squared times the greatest number
+// of Elements contained by any Subset.
+func (c *Cover) Overlaps() map[[2]Subset]int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	ss := subsets(c.in)
+	sortBySprint(ss)
+	t := newTable(c.in, ss)
+	overlaps := make(map[[2]Subset]int)
+	for _, adj := range t.adj {
+		sort.Ints(adj)
+		for x, i := range adj {
+			for _, j := range adj[x+1:] {
+				overlaps[[2]Subset{ss[i], ss[j]}]++
+			}
+		}
+	}
+	return overlaps
+}
+
+// Each calls fn once for each Subset added to c and each Element it contains, in no particular order.
+// fn is called without c locked, so it may call any method of c; Each visits the Subsets and Elements
+// that c contained when it was called, regardless of any changes fn makes.
+func (c *Cover) Each(fn func(s Subset, e Element)) {
+	c.mu.RLock()
+	ss := subsets(c.in)
+	es := make([][]Element, len(ss))
+	for i, s := range ss {
+		es[i] = adjToA(c.in, s)
+	}
+	c.mu.RUnlock()
+
+	for i, s := range ss {
+		for _, e := range es[i] {
+			fn(s, e)
+		}
+	}
+}
+
+// String returns a description of the Subsets added to c and the Elements they contain,
+// one Subset per line in the form "s: {e1, e2}".
+// Subsets and Elements are sorted by their fmt.Sprint representations.
+func (c *Cover) String() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var b strings.Builder
+	for i, in := range c.incidences() {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		fmt.Fprintf(&b, "%v: %s", in.Subset, formatElements(in.Elements))
+	}
+	return b.String()
+}
+
+// formatElements returns es in the form "{e1, e2}".
+func formatElements(es []Element) string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, e := range es {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprint(&b, e)
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// incidence holds a Subset, the Elements it contains, and its cost if one was given by AddWithCost.
+type incidence struct {
+	Subset   Subset    `json:"subset"`
+	Elements []Element `json:"elements"`
+	Cost     *float64  `json:"cost,omitempty"`
+}
+
+// addIncidence records in as if by Add, or by AddWithCost if it has a cost,
+// for a caller that holds c.mu.
+func (c *Cover) addIncidence(in incidence) {
+	if in.Cost != nil {
+		c.addWithCost(in.Subset, *in.Cost, in.Elements...)
+		return
+	}
+	c.add(in.Subset, in.Elements...)
+}
+
+// incidences returns the Subsets added to c and the Elements they contain.
+// Subsets and Elements are sorted by their fmt.Sprint representations.
+func (c *Cover) incidences() []incidence { return c.incidencesOf(c.in) }
+
+// incidencesOf returns the Subsets of g, the Elements they contain in g, and their costs in c.
+// Subsets and Elements are sorted by their fmt.Sprint representations.
+func (c *Cover) incidencesOf(g *bipartite.Graph) []incidence {
+	ss := subsets(g)
+	sortBySprint(ss)
+	ins := make([]incidence, len(ss))
+	for i, s := range ss {
+		es := adjToA(g, s)
+		sortBySprint(es)
+		ins[i] = incidence{Subset: s, Elements: es}
+		if cost, ok := c.cost[s]; ok {
+			ins[i].Cost = &cost
+		}
+	}
+	return ins
 }
 
 // Minimize returns all minimum-length combinations of Subsets that cover every Element.
 // In general, its complexity increases exponentially with the number of Elements.
+// If any Subset contains every Element by itself, Minimize returns each such Subset alone
+// without further simplification.
 func (c *Cover) Minimize() [][]Subset {
-	c.m = bipartite.Copy(c.in)
-	c.essential = make(sset, c.m.NA())
+	return c.Solve().Covers
+}
 
-	isUnique := c.simplify()
+// A Solution holds the result of Solve.
+type Solution struct {
+	// Essential holds the essential Subsets, as returned by Essential.
+	Essential []Subset
 
-	// ess holds the essential Subsets for returning as a slice.
+	// Unique reports whether the essential Subsets cover every Element by themselves,
+	// in which case they constitute the only covering set and no search was required.
+	Unique bool
+
+	// Covers holds the covering sets returned by Minimize, each of which contains the essential Subsets.
+	Covers [][]Subset
+}
+
+// Solve finds the covering sets that Minimize returns, and also reports the essential Subsets
+// and whether simplification alone determined the unique covering set.
+func (c *Cover) Solve() Solution {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	covers := c.minimize()
 	var ess []Subset
 	for s := range c.essential {
 		ess = append(ess, s)
 	}
+	sortBySprint(ess)
+	return Solution{
+		Essential: ess,
+		Unique:    c.m.NB() == 0,
+		Covers:    covers,
+	}
+}
+
+// MinimizeRanked returns the covering sets that Minimize returns, in increasing order of the sum of cost
+// over their Subsets. The Subsets of each covering set are sorted by their fmt.Sprint representations,
+// and covering sets of equal cost are ordered by their fmt.Sprint representations, so that the order is deterministic.
+// The covering sets are still those of minimum length; cost only ranks them.
+// cost is called without c locked, so it may call methods of c such as Cost and Meta.
+func (c *Cover) MinimizeRanked(cost func(Subset) float64) [][]Subset {
+	c.mu.Lock()
+	covers := c.minimize()
+	c.mu.Unlock()
+
+	for _, cs := range covers {
+		sortBySprint(cs)
+	}
+	sortBySprint(covers)
+	costs := make([]float64, len(covers))
+	for i, cs := range covers {
+		for _, s := range cs {
+			costs[i] += cost(s)
+		}
+	}
+	sort.Stable(byCost{covers, costs})
+	return covers
+}
+
+// byCost sorts covering sets in increasing order of their costs.
+type byCost struct {
+	covers [][]Subset
+	costs  []float64
+}
+
+func (b byCost) Len() int           { return len(b.covers) }
+func (b byCost) Less(i, j int) bool { return b.costs[i] < b.costs[j] }
+func (b byCost) Swap(i, j int) {
+	b.covers[i], b.covers[j] = b.covers[j], b.covers[i]
+	b.costs[i], b.costs[j] = b.costs[j], b.costs[i]
+}
+
+// MinimizeWith returns all minimum-length covering sets that contain every Subset of required.
+// Each consists of the required Subsets, in the order given and without repetition, followed by
+// a minimum-length combination of the other Subsets that covers the Elements the required Subsets do not,
+// as Minimize would find for a Cover of only those Elements. If the required Subsets cover every Element,
+// MinimizeWith returns them alone. A required Subset that was not added to c contains no Elements.
+// MinimizeWith does not modify c.
+func (c *Cover) MinimizeWith(required ...Subset) [][]Subset {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var req []Subset
+	seen := make(sset)
+	for _, s := range required {
+		s = c.subset(s, false)
+		if _, ok := seen[s]; !ok {
+			seen[s] = struct{}{}
+			req = append(req, s)
+		}
+	}
+
+	covered := c.covered(req)
+	r := New()
+	r.dontCare = c.dontCare
+	for _, e := range c.in.Bs() {
+		if _, ok := covered[e]; ok {
+			continue
+		}
+		for _, s := range c.in.AdjToB(e) {
+			r.add(s, e)
+		}
+	}
+	if r.in.NB() == 0 {
+		return [][]Subset{req}
+	}
+
+	covers := r.minimize()
+	for i, cs := range covers {
+		covers[i] = append(req[:len(req):len(req)], cs...)
+	}
+	return covers
+}
+
+// ErrNoCover is returned by MinimizeWithout when no covering set exists.
+var ErrNoCover = errors.New("cover: no covering set")
+
+// MinimizeWithout returns all minimum-length covering sets that contain none of the Subsets in excluded,
+// as Minimize would find for a copy of c from which they had been removed.
+// If some Element is contained only by excluded Subsets, there is no such covering set,
+// and MinimizeWithout returns ErrNoCover. Subsets in excluded that were not added to c are ignored.
+// MinimizeWithout does not modify c.
+func (c *Cover) MinimizeWithout(excluded ...Subset) ([][]Subset, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	ex := make(sset, len(excluded))
+	for _, s := range excluded {
+		ex[c.subset(s, false)] = struct{}{}
+	}
+
+	r := New()
+	r.dontCare = c.dontCare
+	for _, s := range c.in.As() {
+		if _, ok := ex[s]; !ok {
+			r.add(s, adjToA(c.in, s)...)
+		}
+	}
+	if r.in.NB() != c.in.NB() {
+		return nil, ErrNoCover
+	}
+	return r.minimize(), nil
+}
+
+// MinimizeCore returns the covering sets that Minimize returns without the essential Subsets that they all contain,
+// which Essential then returns. Each returned combination of Subsets covers the Elements that the essential Subsets do not.
+// If the essential Subsets cover every Element, MinimizeCore returns a single empty combination.
+func (c *Cover) MinimizeCore() [][]Subset {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	covers := c.minimize()
+	for i, cs := range covers {
+		core := make([]Subset, 0, len(cs)-len(c.essential))
+		for _, s := range cs {
+			if _, ok := c.essential[s]; !ok {
+				core = append(core, s)
+			}
+		}
+		covers[i] = core
+	}
+	return covers
+}
+
+// minimize implements Minimize for a caller that holds c.mu exclusively.
+func (c *Cover) minimize() [][]Subset {
+	c.prepare()
+	return c.solve()
+}
+
+// prepare sets c.m to a copy of c.in and c.essential to an empty set.
+func (c *Cover) prepare() {
+	c.m = bipartite.Copy(c.in)
+	c.essential = make(sset, c.in.NA())
+}
+
+// solve implements minimize once c.m holds a copy of c.in and c.essential is empty.
+func (c *Cover) solve() [][]Subset {
+	if covers := c.minimizeFull(); covers != nil {
+		return covers
+	}
+
+	ess, isUnique := c.reduce()
 	if isUnique {
 		// The essential Subsets constitute a unique covering set.
 		return [][]Subset{ess}
 	}
 
 	// At least one non-essential Subset is required to cover at least one Element.
-	// Search all Subset unions of length 1, then 2, and so on until covering sets are found.
-	var covers [][]Subset
-	ss := c.m.As()
-	// Sort the Subsets to search in order of coverage, starting with the largest.
-	sort.Slice(ss, func(i, j int) bool { return c.m.DegA(ss[i]) > c.m.DegA(ss[j]) })
-
-	for w := 1; w <= len(ss); w++ {
-		b := make([]bool, len(ss))
-		for i := 0; i < w; i++ {
-			b[i] = true
-		}
-		for {
-			var ok bool
-			for _, e := range c.m.Bs() {
-				// Check whether any Subsets in ss cover e.
-				// b[i] indicates whether to consider ss[i].
-				ok = false
-				for i, s := range ss {
-					if !b[i] {
-						continue
-					}
-					if ok = c.m.Adjacent(s, e); ok {
-						break
-					}
-				}
-				if !ok {
-					break
-				}
+	return c.searchComponents(ess)
+}
+
+// search returns all minimum-length combinations of Subsets in c.m that cover every Element in c.m,
+// each appended to ess, using petrick if c.m is small enough and branchAndBound otherwise.
+func (c *Cover) search(ess []Subset) [][]Subset {
+	if covers, ok := c.petrick(ess); ok {
+		return covers
+	}
+	covers, nodes := c.branchAndBound(ess)
+	if c.stats != nil {
+		c.stats.Combinations += nodes
+	}
+	return covers
+}
+
+// minimizeFull returns a covering set for each Subset that contains every Element, if there are any.
+// These are the minimum covering sets, and no further simplification or search is necessary.
+// If exactly one Subset contains every Element, it dominates all others and is essential;
+// otherwise, none is essential, and c.m holds them and all Elements.
+// minimizeFull returns nil if no Subset contains every Element or there are no Elements.
+func (c *Cover) minimizeFull() [][]Subset {
+	n := c.in.NB()
+	if n == 0 || c.dontCare != nil {
+		// Don't-care Elements may make one Subset that contains every Element dominate another,
+		// which simplification determines.
+		return nil
+	}
+	var full []Subset
+	for _, s := range subsets(c.in) {
+		if c.in.DegA(s) == n {
+			full = append(full, s)
+		}
+	}
+	if full == nil {
+		return nil
+	}
+
+	empty(c.m)
+	clear(c.essential)
+	if len(full) == 1 {
+		c.essential[full[0]] = struct{}{}
+	} else {
+		for _, s := range full {
+			for _, e := range c.in.AdjToA(s) {
+				c.m.Add(s, e)
 			}
+		}
+	}
+	if c.stats != nil {
+		c.stats.Essential = len(c.essential)
+		c.stats.CoreSubsets, c.stats.CoreElements = c.m.NA(), c.m.NB()
+	}
+	covers := make([][]Subset, len(full))
+	for i, s := range full {
+		c.tracef("%v contains every Element", s)
+		covers[i] = []Subset{s}
+	}
+	return covers
+}
 
-			if ok {
-				// b encodes a valid covering set: all Elements are covered by at least one of the considered Subsets.
-				cs := append(make([]Subset, 0, len(ess)+w), ess...)
-				for i := range ss {
-					if !b[i] {
-						continue
-					}
-					cs = append(cs, ss[i])
-				}
-				covers = append(covers, cs)
+// reduce simplifies c.m, which must hold a copy of c.in, adding essential Subsets to c.essential.
+// It returns the essential Subsets and reports whether they constitute a unique covering set.
+func (c *Cover) reduce() ([]Subset, bool) {
+	isUnique := c.simplify()
+	if c.stats != nil {
+		c.stats.CoreSubsets, c.stats.CoreElements = c.m.NA(), c.m.NB()
+	}
+	if c.trace != nil {
+		if isUnique {
+			c.tracef("essential Subsets cover every Element")
+		} else {
+			c.tracef("searching cyclic core of %d Subsets and %d Elements:", c.m.NA(), c.m.NB())
+			for _, in := range c.incidencesOf(c.m) {
+				c.tracef("\t%v: %s", in.Subset, formatElements(in.Elements))
+			}
+		}
+	}
+
+	// ess holds the essential Subsets for returning as a slice.
+	var ess []Subset
+	for s := range c.essential {
+		ess = append(ess, s)
+	}
+	return ess, isUnique
+}
+
+// Transpose returns a new Cover in which each Element added to c is a Subset
+// that contains the Subsets of c that contain it, as Elements.
+// Costs and the results of any call to Minimize are not carried over.
+func (c *Cover) Transpose() *Cover {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	t := New()
+	for _, s := range c.in.As() {
+		for _, e := range c.in.AdjToA(s) {
+			t.in.Add(e, s)
+		}
+	}
+	return t
+}
+
+// Complement returns a new Cover in which each Subset added to c contains
+// the Elements of universe that it does not contain in c.
+// Elements added to c that are not in universe are ignored,
+// and a Subset that contains every Element of universe in c contains none and is omitted.
+// Costs given by AddWithCost are carried over; the results of any call to Minimize are not.
+func (c *Cover) Complement(universe []Element) *Cover {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	comp := New()
+	for _, s := range subsets(c.in) {
+		var es []Element
+		for _, e := range universe {
+			if !c.in.Adjacent(s, c.element(e, false)) {
+				es = append(es, e)
+			}
+		}
+		if cost, ok := c.cost[s]; ok {
+			comp.addWithCost(s, cost, es...)
+		} else {
+			comp.add(s, es...)
+		}
+	}
+	return comp
+}
+
+// RestrictTo returns a new Cover in which each Subset added to c contains
+// the Elements of es that it contains in c, so that only those Elements need be covered.
+// Elements of es that were not added to c are ignored. Since a Cover records only Subsets
+// that contain some Element, a Subset that contains none of es is omitted.
+// Costs given by AddWithCost are carried over; the results of any call to Minimize are not.
+// RestrictTo does not modify c.
+func (c *Cover) RestrictTo(es []Element) *Cover {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	r := New()
+	for _, e := range es {
+		e = c.element(e, false)
+		for _, s := range c.in.AdjToB(e) {
+			if cost, ok := c.cost[s]; ok {
+				r.addWithCost(s, cost, e)
+			} else {
+				r.add(s, e)
+			}
+		}
+	}
+	return r
+}
+
+// MinHittingSet returns all minimum-length combinations of Elements such that
+// every Subset contains at least one of them. This is the dual of the problem solved by Minimize,
+// which MinHittingSet solves for the Transpose of c.
+func (c *Cover) MinHittingSet() [][]Element {
+	covers := c.Transpose().Minimize()
+	sets := make([][]Element, len(covers))
+	for i, cs := range covers {
+		sets[i] = make([]Element, len(cs))
+		for j, s := range cs {
+			sets[i][j] = s
+		}
+	}
+	return sets
+}
+
+// IsCover reports whether every Element added to c is contained by at least one Subset in ss.
+// Subsets in ss that were not added to c contain no Elements.
+func (c *Cover) IsCover(ss []Subset) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	covered := make(eset, c.in.NB())
+	for _, s := range ss {
+		for _, e := range c.in.AdjToA(c.subset(s, false)) {
+			covered[e] = struct{}{}
+		}
+	}
+	return len(covered) == c.in.NB()
+}
+
+// SubsetOfCover reports whether every Subset in a also appears in b, regardless of order or repetition.
+// Subsets are compared with ==, as they are by a Cover, except that Subsets that are not comparable,
+// and so could not have been added to a Cover, are compared by reflect.DeepEqual instead of causing a panic.
+func SubsetOfCover(a, b []Subset) bool {
+	set := make(map[Subset]struct{}, len(b))
+	var other []Subset
+	for _, s := range b {
+		if isComparable(s) {
+			set[s] = struct{}{}
+		} else {
+			other = append(other, s)
+		}
+	}
+	for _, s := range a {
+		if isComparable(s) {
+			if _, ok := set[s]; !ok {
+				return false
+			}
+		} else if !slices.ContainsFunc(other, func(t Subset) bool { return reflect.DeepEqual(s, t) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// SameCover reports whether a and b contain the same Subsets, regardless of order or repetition,
+// as determined by SubsetOfCover.
+func SameCover(a, b []Subset) bool {
+	return SubsetOfCover(a, b) && SubsetOfCover(b, a)
+}
+
+// isComparable reports whether v can be compared with == without causing a panic.
+func isComparable(v interface{}) bool {
+	return v == nil || reflect.ValueOf(v).Comparable()
+}
+
+// Covered returns the set of Elements contained by at least one Subset in ss.
+// Subsets in ss that were not added to c contain no Elements. The map is newly allocated.
+func (c *Cover) Covered(ss []Subset) map[Element]struct{} {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.covered(ss)
+}
+
+// covered implements Covered for a caller that holds c.mu.
+func (c *Cover) covered(ss []Subset) map[Element]struct{} {
+	covered := make(map[Element]struct{})
+	for _, s := range ss {
+		for _, e := range c.in.AdjToA(c.subset(s, false)) {
+			covered[e] = struct{}{}
+		}
+	}
+	return covered
+}
+
+// Uncovered returns the Elements added to c that are not contained by any Subset in ss,
+// sorted by their fmt.Sprint representations. It returns nil if ss is a covering set.
+func (c *Cover) Uncovered(ss []Subset) []Element {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	covered := c.covered(ss)
+	var es []Element
+	for _, e := range c.in.Bs() {
+		if _, ok := covered[e]; !ok {
+			es = append(es, e)
+		}
+	}
+	sortBySprint(es)
+	return es
+}
+
+// RedundantIn returns the Subsets of cover whose individual removal would leave a covering set,
+// in the order in which they appear in cover. A Subset is redundant if every Element it contains
+// is contained by another Subset of cover; a Subset not added to c contains no Elements and is always redundant.
+// Removing one redundant Subset may make another necessary, so it may not be possible to remove them all.
+// If cover is not a covering set, RedundantIn returns nil.
+func (c *Cover) RedundantIn(cover []Subset) []Subset {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	count := make(map[Element]int, c.in.NB())
+	for _, s := range cover {
+		for _, e := range c.in.AdjToA(c.subset(s, false)) {
+			count[e]++
+		}
+	}
+	if len(count) != c.in.NB() {
+		return nil
+	}
+	var rs []Subset
+	for _, s := range cover {
+		redundant := true
+		for _, e := range c.in.AdjToA(c.subset(s, false)) {
+			if count[e] == 1 {
+				redundant = false
+				break
 			}
-			if !nextPerm(b) {
+		}
+		if redundant {
+			rs = append(rs, s)
+		}
+	}
+	return rs
+}
+
+// MakeIrredundant returns the Subsets of cover that remain after removing redundant ones, as described for RedundantIn,
+// one at a time until none is redundant, in the order in which they appear in cover.
+// It considers the Subsets for removal in order of their fmt.Sprint representations, so the result is deterministic.
+// The Elements contained by the remaining Subsets are those contained by cover, so if cover is a covering set, so is the result.
+func (c *Cover) MakeIrredundant(cover []Subset) []Subset {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	count := make(map[Element]int, c.in.NB())
+	for _, s := range cover {
+		for _, e := range c.in.AdjToA(c.subset(s, false)) {
+			count[e]++
+		}
+	}
+
+	order := make([]int, len(cover))
+	for i := range order {
+		order[i] = i
+	}
+	keys := make([]string, len(cover))
+	for i, s := range cover {
+		keys[i] = fmt.Sprint(s)
+	}
+	sort.SliceStable(order, func(i, j int) bool { return keys[order[i]] < keys[order[j]] })
+
+	removed := make([]bool, len(cover))
+	for _, i := range order {
+		es := c.in.AdjToA(c.subset(cover[i], false))
+		redundant := true
+		for _, e := range es {
+			if count[e] == 1 {
+				redundant = false
 				break
 			}
 		}
-		if len(covers) > 0 {
-			break
+		if redundant {
+			removed[i] = true
+			for _, e := range es {
+				count[e]--
+			}
+		}
+	}
+
+	var rs []Subset
+	for i, s := range cover {
+		if !removed[i] {
+			rs = append(rs, s)
+		}
+	}
+	return rs
+}
+
+// MinimizeSeq returns an iterator over the same covering sets that Minimize returns, in no particular order.
+// It determines the minimum length first and then generates the covering sets of that length one at a time,
+// so that they need not all be held in memory. Each yielded slice is newly allocated.
+// Unlike Minimize, it does not modify c, and the work is done each time the iterator is used.
+func (c *Cover) MinimizeSeq() iter.Seq[[]Subset] {
+	return func(yield func([]Subset) bool) {
+		c.mu.RLock()
+		s, isUnique := c.simplified()
+		c.mu.RUnlock()
+		ess := s.Essential()
+		if isUnique {
+			yield(ess)
+			return
 		}
+
+		ss := subsets(s.m)
+		t := newTable(s.m, ss)
+		u := newBitset(len(t.es))
+		w := s.width()
+		combinations(len(ss), w, func(idx []int) bool {
+			u.clear()
+			for _, i := range idx {
+				u.or(t.cov[i])
+			}
+			if !u.equal(t.all) {
+				return true
+			}
+			cs := append(make([]Subset, 0, len(ess)+w), ess...)
+			for _, i := range idx {
+				cs = append(cs, ss[i])
+			}
+			return yield(cs)
+		})
 	}
+}
 
+// AllCovers returns every combination of at most maxSize Subsets that covers every Element,
+// not only those of minimum length, in order of increasing length.
+// A Subset that is the only one to contain some Element belongs to every covering set,
+// so AllCovers includes each such Subset and enumerates combinations of the others.
+// Each covering set lists those Subsets first, and the Subsets chosen from the rest
+// in the order of their fmt.Sprint representations. AllCovers does not modify c.
+//
+// AllCovers evaluates every combination of up to maxSize of the other Subsets,
+// so the number of covering sets and the time to find them may grow exponentially with maxSize.
+// A maxSize no more than a few greater than MinSize keeps both manageable.
+func (c *Cover) AllCovers(maxSize int) [][]Subset {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var req, rest []Subset
+	for _, s := range subsets(c.in) {
+		if c.isRequired(s) {
+			req = append(req, s)
+		} else {
+			rest = append(rest, s)
+		}
+	}
+	if len(req) > maxSize {
+		return nil
+	}
+	sortBySprint(req)
+	sortBySprint(rest)
+
+	// done holds the Elements of t covered by the required Subsets.
+	t := newTable(c.in, rest)
+	done := newBitset(len(t.es))
+	for _, s := range req {
+		for _, e := range c.in.AdjToA(s) {
+			if j, ok := t.idx[e]; ok {
+				done.add(j)
+			}
+		}
+	}
+
+	var covers [][]Subset
+	u := newBitset(len(t.es))
+	for w := 0; w <= maxSize-len(req); w++ {
+		combinations(len(rest), w, func(idx []int) bool {
+			u.clear()
+			u.or(done)
+			for _, i := range idx {
+				u.or(t.cov[i])
+			}
+			if !u.equal(t.all) {
+				return true
+			}
+			cs := append(make([]Subset, 0, len(req)+w), req...)
+			for _, i := range idx {
+				cs = append(cs, rest[i])
+			}
+			covers = append(covers, cs)
+			return true
+		})
+	}
 	return covers
 }
 
+// isRequired reports whether s is the only Subset added to c that contains some Element.
+func (c *Cover) isRequired(s Subset) bool {
+	for _, e := range c.in.AdjToA(s) {
+		if c.in.DegB(e) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// Essential returns the Subsets that the most recent call to Minimize found to be essential,
+// sorted by their fmt.Sprint representations.
+// Every covering set returned by Minimize contains them, and each one contains some Element
+// that no other Subset remaining after the removal of dominated Subsets contains.
+// Essential returns nil if Minimize has not been called or found no essential Subsets.
+func (c *Cover) Essential() []Subset {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var ess []Subset
+	for s := range c.essential {
+		ess = append(ess, s)
+	}
+	sortBySprint(ess)
+	return ess
+}
+
+// MinSize returns the number of Subsets in a minimum covering set,
+// without generating the covering sets themselves. It does not modify c.
+func (c *Cover) MinSize() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s, isUnique := c.simplified()
+	if isUnique {
+		return len(s.essential)
+	}
+	return len(s.essential) + s.width()
+}
+
+// IsMinimum reports whether cover is a covering set of minimum length, as determined by IsCover and MinSize.
+// Like MinSize, it does not generate any covering sets, so it is suitable for checking a result
+// obtained elsewhere. It does not modify c.
+func (c *Cover) IsMinimum(cover []Subset) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if len(c.covered(cover)) != c.in.NB() {
+		return false
+	}
+	s, isUnique := c.simplified()
+	if isUnique {
+		return len(cover) == len(s.essential)
+	}
+	return len(cover) == len(s.essential)+s.width()
+}
+
+// IsUnique reports whether Minimize returns exactly one covering set, without modifying c.
+// If the essential Subsets found by simplification cover every Element, IsUnique returns true
+// without searching. Otherwise it determines the minimum length and stops counting
+// the covering sets of that length in the cyclic core as soon as it finds a second.
+func (c *Cover) IsUnique() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s, isUnique := c.simplified()
+	if isUnique {
+		return true
+	}
+
+	ss := subsets(s.m)
+	t := newTable(s.m, ss)
+	u := newBitset(len(t.es))
+	var n int
+	combinations(len(ss), s.width(), func(idx []int) bool {
+		u.clear()
+		for _, i := range idx {
+			u.or(t.cov[i])
+		}
+		if u.equal(t.all) {
+			n++
+		}
+		return n < 2
+	})
+	return n == 1
+}
+
+// Simplify performs the reductions that Minimize performs before it searches for covering sets,
+// without modifying c or performing the search. It returns the essential Subsets,
+// the Subsets and Elements of the cyclic core that remain to be searched,
+// and whether the essential Subsets constitute the unique covering set, in which case the core is empty.
+// Each list is sorted by the fmt.Sprint representations of its members.
+func (c *Cover) Simplify() (essential []Subset, coreSubsets []Subset, coreElements []Element, unique bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s, unique := c.simplified()
+	coreSubsets, coreElements = subsets(s.m), elements(s.m)
+	sortBySprint(coreSubsets)
+	sortBySprint(coreElements)
+	return s.Essential(), coreSubsets, coreElements, unique
+}
+
+// Core returns a new Cover of the cyclic core of c: the Subsets and Elements that remain
+// after the reductions that Minimize performs before it searches for covering sets.
+// It does not modify c. Costs given by AddWithCost are carried over; the essential Subsets are not.
+// A covering set of c is the union of the essential Subsets reported by Simplify and a covering set of its Core.
+func (c *Cover) Core() *Cover {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s, _ := c.simplified()
+	core := New()
+	for _, a := range subsets(s.m) {
+		if cost, ok := c.cost[a]; ok {
+			core.addWithCost(a, cost, adjToA(s.m, a)...)
+		} else {
+			core.add(a, adjToA(s.m, a)...)
+		}
+	}
+	return core
+}
+
+// LowerBound returns a lower bound on the number of Subsets in a minimum covering set.
+// It simplifies a copy of c, leaving c unchanged, and returns the number of essential Subsets plus ceil(n/d),
+// where n is the number of Elements that remain to be covered after simplification
+// and d is the greatest number of those Elements contained by any one remaining Subset.
+// Since no Subset contains more than d of those Elements, any covering set needs at least n/d of them,
+// and since a covering set has a whole number of Subsets, at least ceil(n/d).
+// This bounds only the length of a minimum covering set: because of the rounding, it may exceed
+// the optimum of the fractional LP relaxation, which LowerBound does not compute.
+func (c *Cover) LowerBound() float64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lowerBound()
+}
+
+// lowerBound implements LowerBound for a caller that holds c.mu.
+func (c *Cover) lowerBound() float64 {
+	s, _ := c.simplified()
+	var d int
+	for _, a := range s.m.As() {
+		if deg := s.m.DegA(a); deg > d {
+			d = deg
+		}
+	}
+	lb := float64(len(s.essential))
+	if n := s.m.NB(); n > 0 {
+		lb += math.Ceil(float64(n) / float64(d))
+	}
+	return lb
+}
+
+// Gap returns the ratio of the length of cover to LowerBound, which is at least the ratio of its length
+// to that of a minimum covering set. A Gap near 1 indicates that cover, perhaps returned by Greedy, is close to minimum,
+// and a larger one that Minimize may find a shorter covering set.
+// If any Subset has a cost given by AddWithCost, Gap instead returns the ratio of the total cost of cover
+// to a lower bound on the cost of any covering set: the sum over Elements of the least ratio of the cost of a Subset
+// containing it to the number of Elements that Subset contains, which is no greater than the optimum of the fractional LP relaxation.
+// If cover is not a covering set, Gap returns NaN. If the lower bound is 0, Gap returns 1 if cover is empty or costs nothing,
+// and +Inf otherwise.
+func (c *Cover) Gap(cover []Subset) float64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if len(c.covered(cover)) != c.in.NB() {
+		return math.NaN()
+	}
+
+	var total, lb float64
+	if len(c.cost) == 0 {
+		total, lb = float64(len(cover)), c.lowerBound()
+	} else {
+		for _, s := range cover {
+			total += c.costOf(c.subset(s, false))
+		}
+		for _, e := range c.in.Bs() {
+			price := math.Inf(1)
+			for _, s := range c.in.AdjToB(e) {
+				price = min(price, c.costOf(s)/float64(c.in.DegA(s)))
+			}
+			lb += price
+		}
+	}
+	switch {
+	case lb > 0:
+		return total / lb
+	case total == 0:
+		return 1
+	}
+	return math.Inf(1)
+}
+
 // nextPerm implements Knuth's Algorithm L to generate the next lexicographic permutation of b.
 // It reports whether there are more permutations remaining.
 func nextPerm(b []bool) bool {
@@ -165,6 +1668,21 @@ func nextPerm(b []bool) bool {
 	return true
 }
 
+// simplified returns a simplified Cover of c's Subsets and Elements without modifying c,
+// and reports whether its essential Subsets constitute a unique covering set.
+// The returned Cover shares c.in and must not be modified through it.
+func (c *Cover) simplified() (*Cover, bool) {
+	s := &Cover{
+		in: c.in,
+		m:  bipartite.Copy(c.in),
+
+		essential: make(sset, c.in.NA()),
+
+		dontCare: c.dontCare,
+	}
+	return s, s.simplify()
+}
+
 // simplify simplifies c by identifying all essential Subsets.
 // It reports whether the essential Subsets are sufficient to cover all Elements by themselves
 // (and the covering set is therefore unique).
@@ -174,8 +1692,20 @@ func (c *Cover) simplify() bool {
 	// Call them in alternation: c is fully simplified when either does not apply any reductions,
 	// provided that each has been called at least once.
 	c.reduceS()
-	for c.reduceE() && c.reduceS() {
+	for {
+		if c.stats != nil {
+			c.stats.Rounds++
+		}
+		if !c.reduceE() || !c.reduceS() {
+			break
+		}
 	}
+
+	// reduceC removes dominated Elements, which shrinks the cyclic core without changing its covering sets.
+	// It cannot reveal an essential Subset, but the Elements it removes may be all that keep a Subset
+	// from being dominated by another that is not interchangeable with it in a minimum covering set,
+	// so reduceS must not be called afterward.
+	c.reduceC()
 	return c.m.NB() == 0
 }
 
@@ -184,36 +1714,145 @@ func (c *Cover) simplify() bool {
 // The removal of a dominated Subset may reveal another Subset as essential.
 func (c *Cover) reduceS() bool {
 	var ok bool
-	for _, d := range c.m.As() {
-		for _, s := range c.m.As() {
-			if d == s || !c.dominates(d, s) {
+	// Consider the Subsets in a fixed order so that the reductions are reproducible.
+	ss := subsets(c.m)
+	sortBySprint(ss)
+	t := c.table(ss)
+	removed := make([]bool, len(t.ss))
+	for d := range t.ss {
+		if removed[d] {
+			continue
+		}
+		for s := range t.ss {
+			if d == s || removed[s] || !c.dominatesIn(t, d, s) {
 				continue
 			}
-			// s will not appear in any minimal covering solution because d's coverage is a proper superset.
-			c.m.RemoveA(s)
+			// s will not appear in any minimal covering solution because d's coverage is a proper superset,
+			// or d covers the same Elements and is preferred for its don't-care Elements.
+			c.tracef("%v dominated by %v", t.ss[s], t.ss[d])
+			c.m.RemoveA(t.ss[s])
+			removed[s] = true
 			ok = true
+			if c.stats != nil {
+				c.stats.Dominated++
+			}
 		}
 	}
+	if checkReductions {
+		c.checkReduceS(t, removed)
+	}
 	return ok
 }
 
-// dominates reports whether d dominates s; that is, whether d's Elements are a proper superset of s's.
-func (c *Cover) dominates(d, s Subset) bool {
-	for _, e := range c.m.AdjToA(s) {
-		if !c.m.Adjacent(d, e) {
+// checkReductions enables checks of the invariants of the reductions, which panic if they are violated.
+// It is set by tests.
+var checkReductions bool
+
+// checkReduceS checks the result c.m of a call to reduceS that removed the Subsets of t marked in removed.
+// The number of Subsets must not increase, the Subsets that remain in c.m must be exactly those not removed,
+// and a Subset must have been removed if and only if one of those that remain dominates it.
+func (c *Cover) checkReduceS(t *table, removed []bool) {
+	if n := c.m.NA(); n > len(t.ss) {
+		panic(fmt.Sprintf("cover: reduceS: number of Subsets increased from %d to %d", len(t.ss), n))
+	}
+	for s := range t.ss {
+		if removed[s] == (c.m.DegA(t.ss[s]) > 0) {
+			panic(fmt.Sprintf("cover: reduceS: Subset %v removed but present, or kept but absent", t.ss[s]))
+		}
+		var dominated bool
+		for d := range t.ss {
+			if !removed[d] && c.dominatesIn(t, d, s) {
+				dominated = true
+				break
+			}
+		}
+		switch {
+		case dominated && !removed[s]:
+			panic(fmt.Sprintf("cover: reduceS: Subset %v remains dominated", t.ss[s]))
+		case !dominated && removed[s]:
+			panic(fmt.Sprintf("cover: reduceS: Subset %v removed but not dominated by a remaining Subset", t.ss[s]))
+		}
+	}
+}
+
+// dominatesIn reports whether t.ss[d] dominates t.ss[s]; that is, whether its Elements in t are a proper superset
+// of those of t.ss[s], or they are the same and its don't-care Elements are a proper superset.
+func (c *Cover) dominatesIn(t *table, d, s int) bool {
+	if c.dontCare == nil || !t.cov[d].equal(t.cov[s]) {
+		return t.dominates(d, s)
+	}
+	return c.dontCare.more(t.ss[d], t.ss[s])
+}
+
+// dontCares records the don't-care Elements of Subsets given by AddDontCare.
+// Subsets are identified by their keys if key is not nil, so that the don't-care Elements given for a Subset
+// before it is added apply to whichever Subset with the same key represents it.
+// A Cover derived from another for simplification or search may share its dontCares, which it must not modify.
+type dontCares struct {
+	g   *bipartite.Graph
+	key func(Subset) interface{}
+}
+
+// id returns the vertex of s in d.g.
+func (d *dontCares) id(s Subset) interface{} {
+	if d.key == nil {
+		return s
+	}
+	return d.key(s)
+}
+
+// add records that e is a don't-care Element of s.
+func (d *dontCares) add(s Subset, e Element) { d.g.Add(d.id(s), e) }
+
+// elements returns the don't-care Elements of s.
+func (d *dontCares) elements(s Subset) []Element { return adjToA(d.g, d.id(s)) }
+
+// remove removes the don't-care Elements of s and returns them.
+func (d *dontCares) remove(s Subset) []Element {
+	es := d.elements(s)
+	d.g.RemoveA(d.id(s))
+	return es
+}
+
+// more reports whether the don't-care Elements of a are a proper superset of those of b.
+func (d *dontCares) more(a, b Subset) bool {
+	a, b = d.id(a), d.id(b)
+	for _, e := range d.g.AdjToA(b) {
+		if !d.g.Adjacent(a, e) {
 			return false
 		}
 	}
-	return c.m.DegA(d) > c.m.DegA(s)
+	return d.g.DegA(a) > d.g.DegA(b)
+}
+
+// copy returns a copy of d that shares no memory with it.
+func (d *dontCares) copy() *dontCares {
+	return &dontCares{g: bipartite.Copy(d.g), key: d.key}
+}
+
+// table returns a table of the Subsets in ss and the Elements of c.m that they contain,
+// reusing c.tab if it is not nil.
+func (c *Cover) table(ss []Subset) *table {
+	if c.tab == nil {
+		return newTable(c.m, ss)
+	}
+	c.tab.reset(c.m, ss)
+	return c.tab
 }
 
 // reduceE reduces c by identifying essential Subsets, moving them from c.m to c.essential,
 // and removing their Elements from c.m, and reports whether any Elements were removed.
 // When reduceE returns, all Elements in c are contained by at least two Subsets.
-// The removal of an Element may cause a Subset to become dominated.
+// A single pass suffices: removing an essential Subset and its Elements removes an incidence only
+// from those Elements, so no remaining Element's degree falls to 1 as a result.
+// The removal of an Element may cause a Subset to become dominated,
+// and only the removal of that Subset by reduceS can cause another Subset to become essential.
 func (c *Cover) reduceE() bool {
 	var ok bool
-	for _, e := range c.m.Bs() {
+	// Consider the Elements in a fixed order so that the reductions are reproducible.
+	es := elements(c.m)
+	sortBySprint(es)
+	for _, e := range es {
 		if c.m.DegB(e) != 1 {
 			continue
 		}
@@ -222,11 +1861,122 @@ func (c *Cover) reduceE() bool {
 		// e is contained by exactly one Subset, which is therefore essential.
 		// Move it to c.essential and remove it and all Elements it covers.
 		s := c.m.AdjToB(e)[0]
-		for _, ee := range c.m.AdjToA(s) {
+		covered := adjToA(c.m, s)
+		if c.trace != nil {
+			sortBySprint(covered)
+			c.tracef("%v essential, covers %s", s, formatElements(covered))
+		}
+		for _, ee := range covered {
 			c.m.RemoveB(ee)
 		}
 		c.essential[s] = struct{}{}
 		c.m.RemoveA(s)
+		if c.stats != nil {
+			c.stats.Essential++
+		}
+	}
+	return ok
+}
+
+// reduceC reduces c by removing dominated Elements and reports whether any Elements were removed.
+// An Element is dominated if another Element is contained only by Subsets that also contain it,
+// since any covering set of the other Element covers it too. Of two Elements contained by the same Subsets,
+// the one that sorts later by fmt.Sprint is removed.
+// When reduceC returns, c contains no dominated Elements.
+// The removal of an Element may cause a Subset to appear dominated, as described in simplify.
+func (c *Cover) reduceC() bool {
+	var ok bool
+	es := elements(c.m)
+	sortBySprint(es)
+
+	// Precompute the Subsets containing each Element in the order of es.
+	// Removing an Element does not change the Subsets that contain any other.
+	t := c.table(subsets(c.m))
+	w := len(newBitset(len(t.ss)))
+	words := make(bitset, len(es)*w)
+	in := make([]bitset, len(es))
+	for x, e := range es {
+		in[x] = words[x*w : (x+1)*w]
+		for _, i := range t.adj[t.idx[e]] {
+			in[x].add(i)
+		}
+	}
+
+	removed := make([]bool, len(es))
+	for x, f := range es {
+		if removed[x] {
+			continue
+		}
+		for y, g := range es {
+			if removed[y] || x == y || !in[y].contains(in[x]) {
+				continue
+			}
+			// Every covering set of f covers g.
+			c.tracef("%v implied by %v", g, f)
+			c.m.RemoveB(g)
+			removed[y] = true
+			ok = true
+			if c.stats != nil {
+				c.stats.DominatedElements++
+			}
+		}
 	}
 	return ok
 }
+
+// empty removes every vertex from g.
+func empty(g *bipartite.Graph) {
+	for _, a := range g.As() {
+		g.RemoveA(a)
+	}
+}
+
+// subsets returns the Subsets of g.
+func subsets(g *bipartite.Graph) []Subset {
+	ss := make([]Subset, 0, g.NA())
+	for _, s := range g.As() {
+		ss = append(ss, s)
+	}
+	return ss
+}
+
+// elements returns the Elements of g.
+func elements(g *bipartite.Graph) []Element {
+	es := make([]Element, 0, g.NB())
+	for _, e := range g.Bs() {
+		es = append(es, e)
+	}
+	return es
+}
+
+// adjToA returns the Elements of g contained by s.
+func adjToA(g *bipartite.Graph, s Subset) []Element {
+	es := make([]Element, 0, g.DegA(s))
+	for _, e := range g.AdjToA(s) {
+		es = append(es, e)
+	}
+	return es
+}
+
+// sortBySprint sorts xs by the fmt.Sprint representations of its values.
+// Values with identical representations retain their relative order.
+func sortBySprint[T any](xs []T) {
+	keys := make([]string, len(xs))
+	for i, x := range xs {
+		keys[i] = fmt.Sprint(x)
+	}
+	sort.Stable(bySprint[T]{xs, keys})
+}
+
+// bySprint sorts xs by keys, which holds the fmt.Sprint representation of each value in xs.
+type bySprint[T any] struct {
+	xs   []T
+	keys []string
+}
+
+func (s bySprint[T]) Len() int           { return len(s.xs) }
+func (s bySprint[T]) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+func (s bySprint[T]) Swap(i, j int) {
+	s.xs[i], s.xs[j] = s.xs[j], s.xs[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
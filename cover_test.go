@@ -1,12 +1,19 @@
 package cover
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/dkmccandless/bipartite"
 )
 
+func init() {
+	checkReductions = true
+}
+
 // smap returns an sset populated with ss.
 func smap(ss ...Subset) sset {
 	m := make(sset, len(ss))
@@ -94,236 +101,1920 @@ func TestAdd(t *testing.T) {
 				),
 				m: bipartite.New(),
 
-				essential: smap(),
-			},
-		},
-		// Repeated input
-		{
-			[]input{
-				{"Powers of 2", []Element{1, 2, 4, 8}},
-				{"Powers of 2", []Element{1, 2, 4, 8}},
-			},
-			&Cover{
-				in: fromInputs(
-					input{"Powers of 2", []Element{1, 2, 4, 8}},
-				),
-				m: bipartite.New(),
+				essential: smap(),
+			},
+		},
+		// Repeated input
+		{
+			[]input{
+				{"Powers of 2", []Element{1, 2, 4, 8}},
+				{"Powers of 2", []Element{1, 2, 4, 8}},
+			},
+			&Cover{
+				in: fromInputs(
+					input{"Powers of 2", []Element{1, 2, 4, 8}},
+				),
+				m: bipartite.New(),
+
+				essential: smap(),
+			},
+		},
+		// Subsets containing the same Element
+		{
+			[]input{
+				{"Powers of 2", []Element{2}},
+				{"Even primes", []Element{2}},
+			},
+			&Cover{
+				in: fromInputs(
+					input{"Powers of 2", []Element{2}},
+					input{"Even primes", []Element{2}},
+				),
+				m: bipartite.New(),
+
+				essential: smap(),
+			},
+		},
+		// Partial overlap
+		{
+			[]input{
+				{"Powers of 2", []Element{1, 2, 4, 8}},
+				{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8}},
+			},
+			&Cover{
+				in: fromInputs(
+					input{"Powers of 2", []Element{1, 2, 4, 8}},
+					input{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8}},
+				),
+				m: bipartite.New(),
+
+				essential: smap(),
+			},
+		},
+		// Add empty Subset to populated Cover
+		{
+			[]input{
+				{"Powers of 2", []Element{1, 2, 4, 8}},
+				{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8}},
+				{"Odd perfect numbers", []Element{}},
+			},
+			&Cover{
+				in: fromInputs(
+					input{"Powers of 2", []Element{1, 2, 4, 8}},
+					input{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8}},
+				),
+				m: bipartite.New(),
+
+				essential: smap(),
+			},
+		},
+		// Add additional Elements of a Subset
+		{
+			[]input{
+				{"Powers of 2", []Element{1, 2, 4, 8}},
+				{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8}},
+				{"Fibonacci numbers", []Element{13}},
+			},
+			&Cover{
+				in: fromInputs(
+					input{"Powers of 2", []Element{1, 2, 4, 8}},
+					input{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8, 13}},
+				),
+				m: bipartite.New(),
+
+				essential: smap(),
+			},
+		},
+	} {
+		c := New()
+		for _, in := range test.ins {
+			c.Add(in.s, in.es...)
+		}
+		if !reflect.DeepEqual(c, test.want) {
+			t.Errorf("Add(%+v): got %+v, want %+v", test.ins, c, test.want)
+		}
+	}
+}
+
+func TestAddSet(t *testing.T) {
+	for _, test := range []struct {
+		ins  []input
+		want *Cover
+	}{
+		{
+			[]input{{"empty set", nil}},
+			New(),
+		},
+		{
+			[]input{
+				{"Powers of 2", []Element{1, 2, 4, 8}},
+				{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8}},
+				{"Fibonacci numbers", []Element{13}},
+			},
+			&Cover{
+				in: fromInputs(
+					input{"Powers of 2", []Element{1, 2, 4, 8}},
+					input{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8, 13}},
+				),
+				m: bipartite.New(),
+
+				essential: smap(),
+			},
+		},
+	} {
+		c := New()
+		for _, in := range test.ins {
+			c.AddSet(in.s, emap(in.es...))
+		}
+		if !reflect.DeepEqual(c, test.want) {
+			t.Errorf("AddSet(%+v): got %+v, want %+v", test.ins, c, test.want)
+		}
+	}
+}
+
+func TestAddReport(t *testing.T) {
+	c := New()
+	for _, test := range []struct {
+		s    Subset
+		es   []Element
+		want int
+	}{
+		{"A", nil, 0},
+		{"A", []Element{1, 2}, 2},
+		{"A", []Element{1, 2}, 0},
+		{"A", []Element{2, 3, 3}, 1},
+		{"B", []Element{3}, 1},
+		{"B", []Element{1, 3, 4}, 2},
+	} {
+		if got := c.AddReport(test.s, test.es...); got != test.want {
+			t.Errorf("AddReport(%v, %v): got %d, want %d", test.s, test.es, got, test.want)
+		}
+	}
+	if got, want := c.String(), "A: {1, 2, 3}\nB: {1, 3, 4}"; got != want {
+		t.Errorf("AddReport: got %q, want %q", got, want)
+	}
+}
+
+func TestAddWithCost(t *testing.T) {
+	c := New()
+	c.AddWithCost("A", 3, "x", "y")
+	c.AddWithCost("B", 0.5, "y")
+	c.AddWithCost("B", 2, "z")
+	c.Add("C", "z")
+	c.AddWithCost("D", 4)
+	for s, want := range map[Subset]float64{"A": 3, "B": 2, "C": 1, "D": 1, "unknown": 1} {
+		if got := c.Cost(s); got != want {
+			t.Errorf("Cost(%v): got %v, want %v", s, got, want)
+		}
+	}
+	if got, want := c.String(), "A: {x, y}\nB: {y, z}\nC: {z}"; got != want {
+		t.Errorf("AddWithCost: got %q, want %q", got, want)
+	}
+
+	// Costs are copied by Clone and Merge and removed by Reset.
+	if got := c.Clone(); !reflect.DeepEqual(got, c) {
+		t.Errorf("Clone: got %#v, want %#v", got, c)
+	}
+	m := New()
+	m.AddWithCost("A", 5, "w")
+	m.Merge(c)
+	if got := m.Cost("A"); got != 3 {
+		t.Errorf("Cost(A) after Merge: got %v, want 3", got)
+	}
+	if got := m.Cost("C"); got != 1 {
+		t.Errorf("Cost(C) after Merge: got %v, want 1", got)
+	}
+	c.Reset()
+	c.Add("A", "x")
+	if got := c.Cost("A"); got != 1 {
+		t.Errorf("Cost(A) after Reset: got %v, want 1", got)
+	}
+}
+
+func TestMeta(t *testing.T) {
+	type info struct {
+		name     string
+		category int
+	}
+	c := New()
+	c.Add("A", 1, 2)
+	c.Add("B", 2, 3)
+	c.SetMeta("A", info{"first", 1})
+	c.SetMeta("B", info{"second", 2})
+	c.SetMeta("B", info{"second", 3})
+	c.SetMeta("C", "not added")
+	for _, cs := range c.Minimize() {
+		for _, s := range cs {
+			if c.Meta(s) == nil {
+				t.Errorf("Meta(%v): got nil after Minimize", s)
+			}
+		}
+	}
+	for s, want := range map[Subset]interface{}{"A": info{"first", 1}, "B": info{"second", 3}, "C": "not added", "D": nil} {
+		if got := c.Meta(s); got != want {
+			t.Errorf("Meta(%v): got %v, want %v", s, got, want)
+		}
+	}
+	c.SetMeta("C", nil)
+	if got := c.Meta("C"); got != nil {
+		t.Errorf("Meta(C) after removal: got %v, want nil", got)
+	}
+
+	if d := c.Clone(); !reflect.DeepEqual(d, c) {
+		t.Errorf("Clone: got %#v, want %#v", d, c)
+	}
+	c.Reset()
+	if got := c.Meta("A"); got != nil {
+		t.Errorf("Meta(A) after Reset: got %v, want nil", got)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a, b := New(), New()
+	a.Add("Powers of 2", 1, 2, 4, 8)
+	a.Add("Fibonacci numbers", 0, 1, 2, 3)
+	b.Add("Fibonacci numbers", 5, 8)
+	b.Add("Primes", 2, 3, 5, 7)
+	b.Minimize()
+	a.Merge(b)
+	want := &Cover{
+		in: fromInputs(
+			input{"Powers of 2", []Element{1, 2, 4, 8}},
+			input{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8}},
+			input{"Primes", []Element{2, 3, 5, 7}},
+		),
+		m: bipartite.New(),
+
+		essential: smap(),
+	}
+	if !reflect.DeepEqual(a, want) {
+		t.Errorf("Merge: got %v, want %v", a, want)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	a, b := New(), New()
+	a.Add("A", 1, 2)
+	a.Add("B", 2, 3)
+	b.Add("B", 3)
+	b.AddWithCost("A", 5, 2)
+	b.Add("B", 2)
+	b.Add("A", 1)
+	b.Minimize()
+	if !a.Equal(b) || !b.Equal(a) {
+		t.Errorf("Equal(%v, %v): got false", a, b)
+	}
+	if !a.Equal(a) {
+		t.Errorf("Equal(%v, itself): got false", a)
+	}
+
+	for _, f := range []func(c *Cover){
+		func(c *Cover) { c.Add("A", 3) },
+		func(c *Cover) { c.Add("C", 1) },
+		func(c *Cover) { c.Add("C", 4) },
+		func(c *Cover) { c.Reset() },
+	} {
+		c := a.Clone()
+		f(c)
+		if c.Equal(a) || a.Equal(c) {
+			t.Errorf("Equal(%v, %v): got true", c, a)
+		}
+	}
+
+	// Covers with the same numbers of Subsets and Elements but different containment are unequal.
+	c, d := New(), New()
+	c.Add("A", 1, 2)
+	c.Add("B", 2)
+	d.Add("A", 1)
+	d.Add("B", 1, 2)
+	if c.Equal(d) {
+		t.Errorf("Equal(%v, %v): got true", c, d)
+	}
+
+	for name, test := range coverTests {
+		if !test.c.Equal(test.sim) {
+			t.Errorf("Equal(%v, simplified): got false", name)
+		}
+	}
+}
+
+func TestHash(t *testing.T) {
+	a, b := New(), New()
+	a.Add("A", 1, 2)
+	a.Add("B", 2, 3)
+	b.Add("B", 3)
+	b.AddWithCost("A", 5, 2)
+	b.Add("B", 2)
+	b.Add("A", 1)
+	b.Minimize()
+	if a.Hash() != b.Hash() {
+		t.Errorf("Hash(%v) != Hash(%v)", a, b)
+	}
+
+	// Hash is stable across calls and processes.
+	if got, want := New().Hash(), uint64(0xcbf29ce484222325); got != want {
+		t.Errorf("Hash(New()): got %#x, want %#x", got, want)
+	}
+
+	seen := map[uint64]string{a.Hash(): a.String()}
+	for _, f := range []func(c *Cover){
+		func(c *Cover) { c.Add("A", 3) },
+		func(c *Cover) { c.Add("C", 1) },
+		func(c *Cover) { c.Reset() },
+		func(c *Cover) { c.Reset(); c.Add("A", "1, 2") },
+		func(c *Cover) { c.Reset(); c.Add("A", 1, 2, "B", 2, 3) },
+	} {
+		c := a.Clone()
+		f(c)
+		h := c.Hash()
+		if s, ok := seen[h]; ok {
+			t.Errorf("Hash(%v) == Hash(%v)", c, s)
+		}
+		seen[h] = c.String()
+	}
+}
+
+func TestNum(t *testing.T) {
+	for name, test := range map[string]struct {
+		c      *Cover
+		nS, nE int
+	}{
+		"empty set":       {coverTests["empty set"].c, 0, 0},
+		"tautology":       {coverTests["tautology"].c, 1, 1},
+		"B contains A":    {coverTests["B contains A"].c, 2, 3},
+		"seven-segment C": {coverTests["seven-segment C"].c, 7, 12},
+	} {
+		if gotS, gotE := test.c.NumSubsets(), test.c.NumElements(); gotS != test.nS || gotE != test.nE {
+			t.Errorf("NumSubsets, NumElements(%v): got %d, %d; want %d, %d", name, gotS, gotE, test.nS, test.nE)
+		}
+	}
+}
+
+func TestConcurrentAdd(t *testing.T) {
+	const goroutines, n = 8, 100
+	c := New()
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				c.Add([2]int{g, i}, [2]int{g, i}, g)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				_ = c.NumSubsets()
+				_ = c.IsCover(nil)
+				if i%10 == 0 {
+					_ = c.String()
+					c.Minimize()
+					c.Merge(c)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := c.NumSubsets(), goroutines*n; got != want {
+		t.Errorf("NumSubsets: got %d, want %d", got, want)
+	}
+	if got, want := c.NumElements(), goroutines*n+goroutines; got != want {
+		t.Errorf("NumElements: got %d, want %d", got, want)
+	}
+	if got := c.Minimize(); len(got) != 1 || len(got[0]) != goroutines*n {
+		t.Errorf("Minimize: got %d covers, want 1 of length %d", len(got), goroutines*n)
+	}
+}
+
+func TestEach(t *testing.T) {
+	for name, test := range coverTests {
+		got := New()
+		test.c.Each(func(s Subset, e Element) {
+			if got.in.Adjacent(s, e) {
+				t.Errorf("Each(%v): visited %v, %v twice", name, s, e)
+			}
+			got.Add(s, e)
+		})
+		if !got.Equal(test.c) {
+			t.Errorf("Each(%v): got %v, want %v", name, got, test.c)
+		}
+	}
+
+	// fn may modify c, and Each visits only the Elements c contained when it was called.
+	c := New()
+	c.Add("A", 1, 2)
+	c.Add("B", 2)
+	var n int
+	c.Each(func(s Subset, e Element) {
+		n++
+		c.Add(s, e.(int)+10)
+	})
+	if n != 3 || c.Size("A") != 4 || c.Size("B") != 2 {
+		t.Errorf("Each modifying c: visited %d incidences, got %v", n, c)
+	}
+}
+
+func TestSize(t *testing.T) {
+	c := coverTests["B contains A"].c
+	for s, want := range map[Subset]int{"A": 1, "B": 3, "unknown": 0} {
+		if got := c.Size(s); got != want {
+			t.Errorf("Size(%v): got %d, want %d", s, got, want)
+		}
+	}
+
+	// Size reflects the Elements added, not those remaining after Minimize.
+	c = c.Clone()
+	c.Minimize()
+	if got := c.Size("A"); got != 1 {
+		t.Errorf("Size(A) after Minimize: got %d, want 1", got)
+	}
+}
+
+func TestUniverse(t *testing.T) {
+	c := New()
+	if got := c.Universe(); got == nil || len(got) != 0 {
+		t.Errorf("Universe(empty): got %v, want empty map", got)
+	}
+	c.Add("A", 1, 2)
+	c.Add("B", 2, 3)
+	want := map[Element]struct{}{1: {}, 2: {}, 3: {}}
+	u := c.Universe()
+	if !reflect.DeepEqual(u, want) {
+		t.Errorf("Universe: got %v, want %v", u, want)
+	}
+	delete(u, 1)
+	u[4] = struct{}{}
+	if got := c.Universe(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Universe after modifying result: got %v, want %v", got, want)
+	}
+}
+
+func TestFrequency(t *testing.T) {
+	c := coverTests["B contains A"].c.Clone()
+	for i := 0; i < 2; i++ {
+		// Minimize removes Subsets and Elements from its own copy, but Frequency and Size are unchanged.
+		for e, want := range map[Element]int{"x": 2, "y": 1, "z": 1, "unknown": 0} {
+			if got := c.Frequency(e); got != want {
+				t.Errorf("Frequency(%v): got %d, want %d", e, got, want)
+			}
+		}
+		for s, want := range map[Subset]int{"A": 1, "B": 3, "unknown": 0} {
+			if got := c.Size(s); got != want {
+				t.Errorf("Size(%v): got %d, want %d", s, got, want)
+			}
+		}
+		c.Minimize()
+	}
+}
+
+func TestElementIndex(t *testing.T) {
+	c := coverTests["B contains A"].c.Clone()
+	want := map[Element][]Subset{"x": {"A", "B"}, "y": {"B"}, "z": {"B"}}
+	idx := c.ElementIndex()
+	if !reflect.DeepEqual(idx, want) {
+		t.Errorf("ElementIndex(%v): got %v, want %v", c, idx, want)
+	}
+	// Modifying the index does not modify c.
+	idx["x"][0] = "C"
+	delete(idx, "y")
+	if got := c.ElementIndex(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ElementIndex(%v) after modification: got %v, want %v", c, got, want)
+	}
+	if got := New().ElementIndex(); got == nil || len(got) != 0 {
+		t.Errorf("ElementIndex(empty): got %v, want empty map", got)
+	}
+}
+
+func TestElementDegreeHistogram(t *testing.T) {
+	c := coverTests["B contains A"].c
+	if got, want := c.ElementDegreeHistogram(), map[int]int{1: 2, 2: 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ElementDegreeHistogram(%v): got %v, want %v", c, got, want)
+	}
+	for name, test := range coverTests {
+		var n int
+		for d, k := range test.c.ElementDegreeHistogram() {
+			if d < 1 || k < 1 {
+				t.Errorf("ElementDegreeHistogram(%v): got %d Elements of degree %d", name, k, d)
+			}
+			n += k
+		}
+		if want := test.c.NumElements(); n != want {
+			t.Errorf("ElementDegreeHistogram(%v): got %d Elements, want %d", name, n, want)
+		}
+	}
+	if got := New().ElementDegreeHistogram(); got == nil || len(got) != 0 {
+		t.Errorf("ElementDegreeHistogram(empty): got %v, want empty map", got)
+	}
+}
+
+func TestEssentialElements(t *testing.T) {
+	c := coverTests["B contains A"].c
+	if got, want := c.EssentialElements(), []Element{"y", "z"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("EssentialElements(%v): got %v, want %v", c, got, want)
+	}
+	for name, test := range coverTests {
+		c := test.c.Clone()
+		for _, e := range c.EssentialElements() {
+			if c.Frequency(e) != 1 {
+				t.Errorf("EssentialElements(%v): got %v of frequency %d", name, e, c.Frequency(e))
+			}
+		}
+		// Each Subset that contains an essential Element is essential.
+		ess := make(sset)
+		for _, s := range c.Solve().Essential {
+			ess[s] = struct{}{}
+		}
+		for _, e := range c.EssentialElements() {
+			for _, s := range c.in.AdjToB(e) {
+				if _, ok := ess[s]; !ok {
+					t.Errorf("EssentialElements(%v): %v contains %v but is not essential", name, s, e)
+				}
+			}
+		}
+	}
+	if got := New().EssentialElements(); got != nil {
+		t.Errorf("EssentialElements(empty): got %v, want nil", got)
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	c := New()
+	c.Add("A", "x", "y")
+	c.Add("E", "z")
+	c.Add("D", "x")
+	c.Add("B", "y", "x")
+	c.Add("C", "x")
+	c.Add("F", "x", "y", "z")
+	c.Add("G", "y", "x")
+	for name, test := range map[string]struct {
+		c    *Cover
+		want [][]Subset
+	}{
+		"empty set":       {New(), nil},
+		"seven-segment D": {coverTests["seven-segment D"].c, nil},
+		"duplicates":      {c, [][]Subset{{"A", "B", "G"}, {"C", "D"}}},
+	} {
+		if got := test.c.Duplicates(); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Duplicates(%v): got %v, want %v", name, got, test.want)
+		}
+	}
+}
+
+func TestMergeEquivalentElements(t *testing.T) {
+	c := New()
+	c.Add("A", 1, 2, 3, 4)
+	c.Add("B", 2, 3, 5)
+	c.Add("C", 4, 5, 6)
+	c.Add("D", 6, 7)
+	if got := c.MergeEquivalentElements(); got != 1 {
+		t.Errorf("MergeEquivalentElements: got %d, want 1", got)
+	}
+	if got, want := c.String(), "A: {1, 2, 4}\nB: {2, 5}\nC: {4, 5, 6}\nD: {6, 7}"; got != want {
+		t.Errorf("MergeEquivalentElements: got %q, want %q", got, want)
+	}
+	if got := c.MergeEquivalentElements(); got != 0 {
+		t.Errorf("MergeEquivalentElements again: got %d, want 0", got)
+	}
+
+	for name, test := range coverTests {
+		if !strings.HasPrefix(name, "seven-segment") {
+			continue
+		}
+		c := test.c.Clone()
+		n := c.NumElements()
+		got := c.MergeEquivalentElements()
+		if c.NumElements() != n-got {
+			t.Errorf("MergeEquivalentElements(%v): got %d, leaving %d of %d Elements", name, got, c.NumElements(), n)
+		}
+		if covers := c.Minimize(); len(covers) != len(test.min) || !allMatch(covers, test.min) {
+			t.Errorf("MergeEquivalentElements(%v): Minimize got %v, want %v", name, covers, test.min)
+		}
+	}
+}
+
+func TestSubsetOfCover(t *testing.T) {
+	for _, test := range []struct {
+		a, b          []Subset
+		subset, equal bool
+	}{
+		{nil, nil, true, true},
+		{nil, []Subset{"A"}, true, false},
+		{[]Subset{"A"}, nil, false, false},
+		{[]Subset{"A", "B"}, []Subset{"B", "C", "A"}, true, false},
+		{[]Subset{"A", "B"}, []Subset{"B", "A"}, true, true},
+		{[]Subset{"A", "A", "B"}, []Subset{"B", "A"}, true, true},
+		{[]Subset{1, "1"}, []Subset{"1"}, false, false},
+		{[]Subset{nil}, []Subset{nil, "A"}, true, false},
+		{[]Subset{[]int{1, 2}, "A"}, []Subset{"A", []int{1, 2}}, true, true},
+		{[]Subset{[]int{1, 2}}, []Subset{[]int{2, 1}}, false, false},
+		{[]Subset{map[string]int{"x": 1}}, []Subset{[2]int{1, 2}, map[string]int{"x": 1}}, true, false},
+	} {
+		if got := SubsetOfCover(test.a, test.b); got != test.subset {
+			t.Errorf("SubsetOfCover(%v, %v): got %v, want %v", test.a, test.b, got, test.subset)
+		}
+		if got := SameCover(test.a, test.b); got != test.equal {
+			t.Errorf("SameCover(%v, %v): got %v, want %v", test.a, test.b, got, test.equal)
+		}
+	}
+}
+
+func TestSplitSubset(t *testing.T) {
+	c := New()
+	c.AddWithCost("A", 3, 1, 2, 3, 4)
+	c.Add("B", 4, 5)
+	c.Add("C", 5)
+	c.AddDontCare("A", 6, 7)
+	if got, want := c.Minimize(), [][]Subset{{"A", "B"}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Minimize: got %v, want %v", got, want)
+	}
+	odd := func(e Element) bool { return e.(int)%2 == 1 }
+	c.SplitSubset("A", "A1", "C", odd)
+
+	want := New()
+	want.Add("A1", 1, 3)
+	want.Add("B", 4, 5)
+	want.Add("C", 2, 4, 5)
+	if !c.Equal(want) {
+		t.Errorf("SplitSubset: got %v, want %v", c, want)
+	}
+	if ess := c.Essential(); ess != nil {
+		t.Errorf("SplitSubset: got Essential %v, want nil", ess)
+	}
+	if got := c.Cost("A"); got != 1 {
+		t.Errorf("SplitSubset: got Cost(A) %v, want 1", got)
+	}
+	if !c.dontCare.g.Adjacent("A1", 7) || !c.dontCare.g.Adjacent("C", 6) || c.dontCare.g.DegA("A") != 0 {
+		t.Errorf("SplitSubset: don't-care Elements not divided between A1 and C")
+	}
+	if got, want := c.Minimize(), [][]Subset{{"A1", "C"}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Minimize after SplitSubset: got %v, want %v", got, want)
+	}
+
+	// A Subset that receives no Elements is not added, and splitting an unknown Subset has no effect.
+	c.SplitSubset("A1", "D", "E", odd)
+	c.SplitSubset("F", "G", "H", odd)
+	want = New()
+	want.Add("D", 1, 3)
+	want.Add("B", 4, 5)
+	want.Add("C", 2, 4, 5)
+	if !c.Equal(want) {
+		t.Errorf("SplitSubset: got %v, want %v", c, want)
+	}
+
+	// inA may call methods of c.
+	c = New()
+	c.Add("A", 1, 2, 3)
+	c.Add("B", 1)
+	c.SplitSubset("A", "A1", "A2", func(e Element) bool { return c.Frequency(e) > 1 })
+	want = New()
+	want.Add("A1", 1)
+	want.Add("A2", 2, 3)
+	want.Add("B", 1)
+	if !c.Equal(want) {
+		t.Errorf("SplitSubset by Frequency: got %v, want %v", c, want)
+	}
+	// Elements added to s while inA is called are divided too.
+	c.SplitSubset("A2", "C", "D", func(e Element) bool {
+		c.Add("A2", 4)
+		return e == 2
+	})
+	want = New()
+	want.Add("A1", 1)
+	want.Add("B", 1)
+	want.Add("C", 2)
+	want.Add("D", 3, 4)
+	if !c.Equal(want) {
+		t.Errorf("SplitSubset adding to s: got %v, want %v", c, want)
+	}
+
+	// A Subset that receives no Elements receives no don't-care Elements,
+	// and a Subset added later with the key of s represents it.
+	upper := func(s Subset) interface{} { return strings.ToUpper(s.(string)) }
+	c = NewWithKeys(upper, nil)
+	c.Add("a", 1, 3)
+	c.Add("B", 1, 3)
+	c.AddDontCare("A", 2)
+	c.SplitSubset("A", "X", "Y", odd)
+	c.Add("Y", 1, 3)
+	c.Add("A", 5)
+	if got, want := c.Minimize(), [][]Subset{{"A", "B"}, {"A", "X"}, {"A", "Y"}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Minimize after SplitSubset with keys: got %v, want %v", got, want)
+	}
+}
+
+func TestMergeSubsets(t *testing.T) {
+	c := New()
+	c.AddWithCost("A", 3, 1, 2)
+	c.Add("B", 2, 3)
+	c.Add("C", 3, 4)
+	c.Add("D", 1)
+	c.AddDontCare("B", 5)
+	if got, want := c.Minimize(), [][]Subset{{"A", "C"}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Minimize: got %v, want %v", got, want)
+	}
+	c.MergeSubsets("A", "B", "AB")
+
+	want := New()
+	want.Add("AB", 1, 2, 3)
+	want.Add("C", 3, 4)
+	want.Add("D", 1)
+	if !c.Equal(want) {
+		t.Errorf("MergeSubsets: got %v, want %v", c, want)
+	}
+	if ess := c.Essential(); ess != nil {
+		t.Errorf("MergeSubsets: got Essential %v, want nil", ess)
+	}
+	if got := c.Cost("A"); got != 1 {
+		t.Errorf("MergeSubsets: got Cost(A) %v, want 1", got)
+	}
+	if !c.dontCare.g.Adjacent("AB", 5) || c.dontCare.g.DegA("B") != 0 {
+		t.Errorf("MergeSubsets: don't-care Elements not moved to AB")
+	}
+	if got, want := c.Minimize(), [][]Subset{{"AB", "C"}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Minimize after MergeSubsets: got %v, want %v", got, want)
+	}
+
+	// An unknown Subset contains no Elements, and merged may be one of the Subsets merged.
+	c.MergeSubsets("C", "E", "C")
+	c.MergeSubsets("D", "AB", "AB")
+	want = New()
+	want.Add("AB", 1, 2, 3)
+	want.Add("C", 3, 4)
+	if !c.Equal(want) {
+		t.Errorf("MergeSubsets: got %v, want %v", c, want)
+	}
+
+	// A Subset added later with the key of a or b represents it.
+	c = NewWithKeys(func(s Subset) interface{} { return strings.ToUpper(s.(string)) }, nil)
+	c.Add("a", 1)
+	c.Add("b", 2)
+	c.MergeSubsets("A", "B", "AB")
+	c.Add("A", 3)
+	c.Add("B", 4)
+	if got, want := c.Minimize(), [][]Subset{{"A", "AB", "B"}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Minimize after MergeSubsets with keys: got %v, want %v", got, want)
+	}
+}
+
+func TestDominance(t *testing.T) {
+	c := New()
+	c.Add("A", 1, 2, 3, 4)
+	c.Add("B", 1, 2)
+	c.Add("C", 2)
+	c.Add("D", 1, 2)
+	c.Add("E", 4, 5)
+	c.Minimize()
+	for _, test := range []struct {
+		s                     Subset
+		dominated, dominators []Subset
+	}{
+		{"A", []Subset{"B", "C", "D"}, nil},
+		{"B", []Subset{"C"}, []Subset{"A"}},
+		{"C", nil, []Subset{"A", "B", "D"}},
+		{"D", []Subset{"C"}, []Subset{"A"}},
+		{"E", nil, nil},
+		{"F", nil, nil},
+	} {
+		if got := c.Dominated(test.s); !reflect.DeepEqual(got, test.dominated) {
+			t.Errorf("Dominated(%v): got %v, want %v", test.s, got, test.dominated)
+		}
+		if got := c.Dominators(test.s); !reflect.DeepEqual(got, test.dominators) {
+			t.Errorf("Dominators(%v): got %v, want %v", test.s, got, test.dominators)
+		}
+	}
+}
+
+func TestMaximal(t *testing.T) {
+	c := New()
+	c.Add("A", 1, 2, 3, 4)
+	c.Add("B", 1, 2)
+	c.Add("C", 2)
+	c.Add("D", 1, 2)
+	c.Add("E", 4, 5)
+	c.Add("F", 5, 4)
+	if got, want := c.Maximal(), []Subset{"A", "E", "F"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Maximal(%v): got %v, want %v", c, got, want)
+	}
+
+	for name, test := range coverTests {
+		c := test.c.Clone()
+		var want []Subset
+		for _, s := range subsets(c.in) {
+			if c.Dominators(s) == nil {
+				want = append(want, s)
+			}
+		}
+		sortBySprint(want)
+		if got := c.Maximal(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Maximal(%v): got %v, want %v", name, got, want)
+		}
+		if !reflect.DeepEqual(c, test.c) {
+			t.Errorf("Maximal(%v): modified Cover to %+v", name, c)
+		}
+	}
+	if got := New().Maximal(); got != nil {
+		t.Errorf("Maximal(empty): got %v, want nil", got)
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	c := New()
+	c.Add("A", 1, 2, 3)
+	c.Add("B", 2, 3, 4, 5)
+	c.Add("C", 3, 2, 1)
+	c.Add("D", 6)
+	for _, test := range []struct {
+		a, b Subset
+		want float64
+	}{
+		{"A", "B", 0.4},
+		{"B", "A", 0.4},
+		{"A", "C", 1},
+		{"A", "A", 1},
+		{"A", "D", 0},
+		{"A", "E", 0},
+		{"E", "F", 0},
+	} {
+		if got := c.Similarity(test.a, test.b); got != test.want {
+			t.Errorf("Similarity(%v, %v): got %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	c := New()
+	c.Add("B", 1, 2, 3)
+	c.Add("A", 2, 3, 4)
+	c.Add("C", 3, 5)
+	c.Add("D", 6)
+	want := map[[2]Subset]int{
+		{"A", "B"}: 2,
+		{"A", "C"}: 1,
+		{"B", "C"}: 1,
+	}
+	if got := c.Overlaps(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Overlaps(%v): got %v, want %v", c, got, want)
+	}
+	if got := New().Overlaps(); len(got) != 0 {
+		t.Errorf("Overlaps(empty): got %v", got)
+	}
+}
+
+func TestString(t *testing.T) {
+	for _, test := range []struct {
+		c    *Cover
+		want string
+	}{
+		{New(), ""},
+		{coverTests["tautology"].c, "true: {true}"},
+		{coverTests["B contains A"].c, "A: {x}\nB: {x, y, z}"},
+		{coverTests["seven-segment B"].c, `-0-0: {0, 10, 2, 8}
+-00-: {0, 1, 8, 9}
+0-00: {0, 4}
+0-11: {3, 7}
+00--: {0, 1, 2, 3}
+1-01: {13, 9}`},
+	} {
+		if got := test.c.String(); got != test.want {
+			t.Errorf("String(%+v): got %q, want %q", test.c, got, test.want)
+		}
+	}
+}
+
+// dominates reports whether d dominates s; that is, whether d's Elements are a proper superset of s's,
+// or they are the same and d's don't-care Elements are a proper superset of s's.
+func (c *Cover) dominates(d, s Subset) bool {
+	for _, e := range c.m.AdjToA(s) {
+		if !c.m.Adjacent(d, e) {
+			return false
+		}
+	}
+	if c.m.DegA(d) == c.m.DegA(s) {
+		return c.dontCare != nil && c.dontCare.more(d, s)
+	}
+	return c.m.DegA(d) > c.m.DegA(s)
+}
+
+func TestDominates(t *testing.T) {
+	for _, test := range []struct {
+		c   *Cover
+		dom map[Subset]sset
+	}{
+		{
+			&Cover{
+				in: fromInputs(input{true, []Element{true}}),
+				m:  fromInputs(input{true, []Element{true}}),
+
+				essential: smap(),
+			},
+			map[Subset]sset{},
+		},
+		{
+			&Cover{
+				in: fromInputs(
+					input{"A", []Element{"x"}},
+					input{"B", []Element{"y"}},
+				),
+				m: fromInputs(
+					input{"A", []Element{"x"}},
+					input{"B", []Element{"y"}},
+				),
+				essential: smap(),
+			},
+			map[Subset]sset{},
+		},
+		{
+			&Cover{
+				in: fromInputs(
+					input{"A", []Element{"x"}},
+					input{"B", []Element{"x", "y", "z"}},
+				),
+				m: fromInputs(
+					input{"A", []Element{"x"}},
+					input{"B", []Element{"x", "y", "z"}},
+				),
+				essential: smap(),
+			},
+			map[Subset]sset{"B": smap("A")},
+		},
+		{
+			&Cover{
+				in: fromInputs(
+					input{"A", []Element{2}},
+					input{"B", []Element{2, 6}},
+					input{"C", []Element{2, 6}},
+					input{"D", []Element{1, 2, 4}},
+					input{"E", []Element{3, 5, 7}},
+					input{"F", []Element{0, 1, 2, 4, 7}},
+				),
+				m: fromInputs(
+					input{"A", []Element{2}},
+					input{"B", []Element{2, 6}},
+					input{"C", []Element{2, 6}},
+					input{"D", []Element{1, 2, 4}},
+					input{"E", []Element{3, 5, 7}},
+					input{"F", []Element{0, 1, 2, 4, 7}},
+				),
+				essential: smap(),
+			},
+			map[Subset]sset{
+				"B": smap("A"),
+				"C": smap("A"),
+				"D": smap("A"),
+				"F": smap("A", "D"),
+			},
+		},
+	} {
+		for a := range test.c.m.As() {
+			for b := range test.c.m.As() {
+				_, want := test.dom[a][b]
+				if got := test.c.dominates(a, b); got != want {
+					t.Errorf("dominates(%+v, %v, %v): got %v, want %v", test.c, a, b, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestClone(t *testing.T) {
+	for name, test := range coverTests {
+		for _, c := range []*Cover{test.c, test.s, test.e, test.sim} {
+			if got := c.Clone(); !reflect.DeepEqual(c, got) {
+				t.Errorf("Clone(%v, %#v): got %#v", name, c, got)
+			}
+		}
+
+		// Modifying a clone must not modify the original.
+		want := test.c.Clone()
+		c := test.c.Clone()
+		c.Minimize()
+		c.Add("new Subset", "new Element")
+		if !reflect.DeepEqual(test.c, want) {
+			t.Errorf("Clone(%v): modifying clone %v modified original", name, c)
+		}
+	}
+}
+
+func TestReset(t *testing.T) {
+	for name, test := range coverTests {
+		c := test.c.Clone()
+		c.Minimize()
+		c.Reset()
+		if !reflect.DeepEqual(c, New()) {
+			t.Errorf("Reset(%v): got %#v", name, c)
+		}
+
+		// A reused Cover must behave as a new one.
+		c.Add("stale Subset", "stale Element")
+		c.Minimize()
+		c.Reset()
+		c.Merge(test.c)
+		if got := c.Minimize(); len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("Minimize(%v) after Reset: got %v, want %v", name, got, test.min)
+		}
+	}
+}
+
+func TestReduceS(t *testing.T) {
+	for name, test := range coverTests {
+		// Map iteration order varies between runs, but the result must not.
+		for i := 0; i < 10; i++ {
+			got := test.c.Clone()
+			if gotok := got.reduceS(); gotok != test.sok || !reflect.DeepEqual(got, test.s) {
+				t.Errorf("reduceS(%v): got %+v, %v; want %+v, %v", name, got, gotok, test.s, test.sok)
+			}
+		}
+	}
+}
+
+func TestReduceSEqual(t *testing.T) {
+	// Subsets containing the same Elements do not dominate each other, so reduceS keeps them all.
+	c := New()
+	for _, s := range []Subset{"A", "B", "C", "D"} {
+		c.Add(s, 1, 2, 3)
+	}
+	c.Add("E", 3, 4)
+	c.Add("F", 3, 4)
+	c.Add("G", 4)
+	c.m = bipartite.Copy(c.in)
+	if !c.reduceS() {
+		t.Errorf("reduceS: got false, want true")
+	}
+	got := subsets(c.m)
+	sortBySprint(got)
+	if want := []Subset{"A", "B", "C", "D", "E", "F"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("reduceS: got Subsets %v, want %v", got, want)
+	}
+	if c.reduceS() {
+		t.Errorf("reduceS again: got true, want false")
+	}
+
+	// checkReduceS detects a Subset removed without being dominated.
+	ss := subsets(c.in)
+	sortBySprint(ss)
+	tab := newTable(c.in, ss)
+	removed := make([]bool, len(ss))
+	removed[0] = true
+	c.m = bipartite.Copy(c.in)
+	c.m.RemoveA(ss[0])
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("checkReduceS: removal of %v did not panic", ss[0])
+			}
+		}()
+		c.checkReduceS(tab, removed)
+	}()
+}
+
+func TestAddDontCare(t *testing.T) {
+	// A and B contain the same Elements, so neither dominates the other until A has a don't-care Element.
+	c := New()
+	c.Add("A", 1, 2)
+	c.Add("B", 1, 2)
+	c.Add("C", 2, 3)
+	c.Add("D", 3)
+	if got, want := c.Minimize(), [][]Subset{{"A", "C"}, {"B", "C"}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Minimize: got %v, want %v", got, want)
+	}
+	c.AddDontCare("A", "x")
+	// Only E contains the don't-care Element y, but it need not be covered, so E is not essential.
+	c.AddDontCare("E", "y")
+	c.Add("E", 3)
+	// F contains only don't-care Elements and is not added.
+	c.AddDontCare("F", "x", "y")
+	if got, want := c.Minimize(), [][]Subset{{"A", "C"}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Minimize with don't-cares: got %v, want %v", got, want)
+	}
+	if ess := c.Essential(); !reflect.DeepEqual(ess, []Subset{"A", "C"}) {
+		t.Errorf("Essential with don't-cares: got %v, want [A C]", ess)
+	}
+	if got := c.Dominators("B"); !reflect.DeepEqual(got, []Subset{"A"}) {
+		t.Errorf("Dominators(B): got %v, want [A]", got)
+	}
+	// D and E contain the same Element, and E's don't-care Element makes it dominate D.
+	if got := c.Dominated("E"); !reflect.DeepEqual(got, []Subset{"D"}) {
+		t.Errorf("Dominated(E): got %v, want [D]", got)
+	}
+	if got, want := c.Maximal(), []Subset{"A", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Maximal: got %v, want %v", got, want)
+	}
+	if got := c.NumElements(); got != 3 {
+		t.Errorf("NumElements: got %d, want 3", got)
+	}
+	if got := c.NumSubsets(); got != 5 {
+		t.Errorf("NumSubsets: got %d, want 5", got)
+	}
+	if got, want := c.Clone().Minimize(), c.Minimize(); len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Clone: don't-care Elements not copied: Minimize got %v, want %v", got, want)
+	}
+	c.Reset()
+	c.Add("A", 1)
+	c.Add("B", 1)
+	if got := c.Minimize(); len(got) != 2 {
+		t.Errorf("Minimize after Reset: got %v, want 2 covering sets", got)
+	}
+
+	// A contains every Element, but so does B, which it dominates.
+	c.AddDontCare("A", 9)
+	if got, want := c.Minimize(), [][]Subset{{"A"}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Minimize with full Subsets: got %v, want %v", got, want)
+	}
+
+	c = New()
+	c.Add("A", 1, 2)
+	c.Add("B", 1, 2)
+	c.Add("C", 2, 3)
+	c.AddDontCare("A", 9)
+	c.AddDontCare("E", 9)
+	c.MinimizeIncremental()
+	// E, whose don't-care Element was given before the last call to MinimizeIncremental, dominates D.
+	c.Add("D", 4, 5)
+	c.Add("E", 4, 5)
+	if got, want := c.MinimizeIncremental(), c.Clone().Minimize(); len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("MinimizeIncremental: got %v, want %v", got, want)
+	}
+	c.Add("F", 6)
+	c.Add("G", 6)
+	if got, want := c.MinimizeIncremental(), c.Clone().Minimize(); len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("MinimizeIncremental after Add: got %v, want %v", got, want)
+	}
+	c.Add("D", 1, 3)
+	var sv Solver
+	if got, want := sv.Minimize(c), c.Minimize(); len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Solver.Minimize: got %v, want %v", got, want)
+	}
+
+	// Don't-care Elements given for a Subset before it is added apply to the Subset that represents its key,
+	// but do not choose it.
+	c = NewWithKeys(func(s Subset) interface{} { return strings.ToUpper(s.(string)) }, nil)
+	c.AddDontCare("a", 9)
+	c.Add("A", 1, 2)
+	c.Add("B", 1, 2)
+	if got, want := c.Minimize(), [][]Subset{{"A"}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Minimize with keys: got %v, want %v", got, want)
+	}
+}
+
+func TestReduceE(t *testing.T) {
+	for name, test := range coverTests {
+		got := test.c.Clone()
+		if gotok := got.reduceE(); gotok != test.eok || !reflect.DeepEqual(got, test.e) {
+			t.Errorf("reduceE(%v): got %+v, %v; want %+v, %v", name, got, gotok, test.e, test.eok)
+		}
+	}
+}
+
+func TestReduceEFixpoint(t *testing.T) {
+	// In a chain of Subsets {i, i+1}, each essential Subset leaves the next one dominated,
+	// so that no further Subset becomes essential until reduceS removes it.
+	chain := New()
+	for i := 0; i < 20; i++ {
+		chain.Add(i, i, i+1)
+	}
+	covers := []*Cover{chain}
+	for _, test := range coverTests {
+		covers = append(covers, test.c)
+	}
+	for seed := int64(0); seed < 10; seed++ {
+		covers = append(covers, Generate(seed, 20, 20, 0.15))
+	}
+
+	for _, c := range covers {
+		c = c.Clone()
+		c.m = bipartite.Copy(c.in)
+		c.reduceE()
+		for _, e := range c.m.Bs() {
+			if c.m.DegB(e) < 2 {
+				t.Errorf("reduceE(%v): Element %v has degree %d", c, e, c.m.DegB(e))
+			}
+		}
+		if c.reduceE() {
+			t.Errorf("reduceE(%v): second call reported reductions", c)
+		}
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	for name, test := range coverTests {
+		got := test.c.Clone()
+		if gotok := got.simplify(); gotok != test.simok || !reflect.DeepEqual(got, test.sim) {
+			t.Errorf("simplify(%v): got %+v, %v; want %+v, %v", name, got, gotok, test.sim, test.simok)
+		}
+	}
+}
+
+func TestSimplifyPublic(t *testing.T) {
+	for name, test := range coverTests {
+		want := test.c.Clone()
+		ess, ss, es, unique := test.c.Simplify()
+		if !reflect.DeepEqual(test.c, want) {
+			t.Errorf("Simplify(%v): modified receiver", name)
+		}
+
+		wantEss := test.sim.Essential()
+		wantSS, wantES := subsets(test.sim.m), elements(test.sim.m)
+		sortBySprint(wantSS)
+		sortBySprint(wantES)
+		if !reflect.DeepEqual(ess, wantEss) || !reflect.DeepEqual(ss, wantSS) || !reflect.DeepEqual(es, wantES) || unique != test.simok {
+			t.Errorf("Simplify(%v): got %v, %v, %v, %v; want %v, %v, %v, %v",
+				name, ess, ss, es, unique, wantEss, wantSS, wantES, test.simok)
+		}
+	}
+}
+
+func TestCore(t *testing.T) {
+	for name, test := range coverTests {
+		want := test.c.Clone()
+		core := test.c.Core()
+		if !reflect.DeepEqual(test.c, want) {
+			t.Errorf("Core(%v): modified receiver", name)
+		}
+		if !reflect.DeepEqual(core.in, test.sim.m) {
+			t.Errorf("Core(%v): got %v, want %v", name, core.in, test.sim.m)
+		}
+
+		// The minimum covering sets of c are those of its Core together with the essential Subsets.
+		ess, _, _, _ := test.c.Simplify()
+		var got [][]Subset
+		for _, cs := range core.Minimize() {
+			got = append(got, append(cs, ess...))
+		}
+		if len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("Core(%v): Minimize with essential Subsets got %v, want %v", name, got, test.min)
+		}
+	}
+
+	c := New()
+	c.AddWithCost("A", 2, 1, 2)
+	c.AddWithCost("B", 3, 2, 3)
+	c.AddWithCost("C", 5, 3, 1)
+	if got := c.Core().Cost("B"); got != 3 {
+		t.Errorf("Core: got cost %v for B, want 3", got)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	for name, test := range coverTests {
+		c := test.c.Clone()
+		sol := c.Solve()
+		if len(sol.Covers) != len(test.min) || !allMatch(sol.Covers, test.min) {
+			t.Errorf("Solve(%v): got covering sets %v, want %v", name, sol.Covers, test.min)
+		}
+		if want := c.Essential(); !reflect.DeepEqual(sol.Essential, want) {
+			t.Errorf("Solve(%v): got essential Subsets %v, want %v", name, sol.Essential, want)
+		}
+		if _, _, _, want := test.c.Simplify(); sol.Unique != want && test.c.NumSubsets() > 0 {
+			t.Errorf("Solve(%v): got Unique %v, want %v", name, sol.Unique, want)
+		}
+		if sol.Unique && (len(sol.Covers) != 1 || len(sol.Covers[0]) != len(sol.Essential)) {
+			t.Errorf("Solve(%v): got Unique with covering sets %v and essential Subsets %v", name, sol.Covers, sol.Essential)
+		}
+	}
+}
+
+func TestMinimizeRanked(t *testing.T) {
+	c := New()
+	c.Add("A", 1, 2)
+	c.Add("B", 2, 3)
+	c.Add("C", 3, 4)
+	c.Add("D", 4, 1)
+	cost := map[Subset]float64{"A": 3, "B": 1, "C": 2, "D": 2}
+	want := [][]Subset{{"B", "D"}, {"A", "C"}}
+	for i := 0; i < 10; i++ {
+		if got := c.MinimizeRanked(func(s Subset) float64 { return cost[s] }); !reflect.DeepEqual(got, want) {
+			t.Errorf("MinimizeRanked(%v): got %v, want %v", c, got, want)
+		}
+	}
+
+	// Covering sets of equal cost are ordered by their representations.
+	cost["B"] = 3
+	want = [][]Subset{{"A", "C"}, {"B", "D"}}
+	if got := c.MinimizeRanked(func(s Subset) float64 { return cost[s] }); !reflect.DeepEqual(got, want) {
+		t.Errorf("MinimizeRanked(%v): got %v, want %v", c, got, want)
+	}
+
+	// cost may read metadata from c.
+	for s, v := range cost {
+		c.SetMeta(s, v)
+	}
+	if got := c.MinimizeRanked(func(s Subset) float64 { return c.Meta(s).(float64) }); !reflect.DeepEqual(got, want) {
+		t.Errorf("MinimizeRanked by Meta(%v): got %v, want %v", c, got, want)
+	}
+
+	for name, test := range coverTests {
+		if got := test.c.Clone().MinimizeRanked(func(Subset) float64 { return 1 }); len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("MinimizeRanked(%v): got %v, want %v", name, got, test.min)
+		}
+	}
+}
+
+func TestMinimizeWith(t *testing.T) {
+	// The Subsets form a cycle, so none is essential and there are two minimum covering sets.
+	// Requiring A leaves only C to complete a covering set.
+	c := New()
+	c.Add("A", 1, 2)
+	c.Add("B", 2, 3)
+	c.Add("C", 3, 4)
+	c.Add("D", 4, 1)
+	if got, want := c.Minimize(), [][]Subset{{"A", "C"}, {"B", "D"}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Minimize(%v): got %v, want %v", c, got, want)
+	}
+	for _, test := range []struct {
+		required, prefix []Subset
+		want             [][]Subset
+	}{
+		{[]Subset{"A"}, []Subset{"A"}, [][]Subset{{"A", "C"}}},
+		{[]Subset{"A", "B"}, []Subset{"A", "B"}, [][]Subset{{"A", "B", "C"}, {"A", "B", "D"}}},
+		{[]Subset{"B", "A", "B"}, []Subset{"B", "A"}, [][]Subset{{"B", "A", "C"}, {"B", "A", "D"}}},
+		{[]Subset{"C", "A"}, []Subset{"C", "A"}, [][]Subset{{"C", "A"}}},
+		{[]Subset{"E"}, []Subset{"E"}, [][]Subset{{"E", "A", "C"}, {"E", "B", "D"}}},
+	} {
+		got := c.MinimizeWith(test.required...)
+		if len(got) != len(test.want) || !allMatch(got, test.want) {
+			t.Errorf("MinimizeWith(%v): got %v, want %v", test.required, got, test.want)
+		}
+		// The required Subsets come first, in order and without repetition.
+		for _, cs := range got {
+			if !reflect.DeepEqual(cs[:len(test.prefix)], test.prefix) {
+				t.Errorf("MinimizeWith(%v): got %v, want %v first", test.required, cs, test.prefix)
+			}
+		}
+	}
+
+	for name, test := range coverTests {
+		c := test.c.Clone()
+		if got := c.MinimizeWith(); len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("MinimizeWith(%v): got %v, want %v", name, got, test.min)
+		}
+		if !reflect.DeepEqual(c, test.c) {
+			t.Errorf("MinimizeWith(%v): modified Cover to %+v", name, c)
+		}
+	}
+}
+
+func TestMinimizeWithout(t *testing.T) {
+	c := New()
+	c.Add("A", 1, 2)
+	c.Add("B", 2, 3)
+	c.Add("C", 3, 4)
+	c.Add("D", 4, 1)
+	c.Add("E", 5)
+	for _, test := range []struct {
+		excluded []Subset
+		want     [][]Subset
+		err      error
+	}{
+		{nil, [][]Subset{{"E", "A", "C"}, {"E", "B", "D"}}, nil},
+		{[]Subset{"A"}, [][]Subset{{"E", "B", "D"}}, nil},
+		{[]Subset{"A", "F"}, [][]Subset{{"E", "B", "D"}}, nil},
+		{[]Subset{"A", "B"}, nil, ErrNoCover},
+		{[]Subset{"E"}, nil, ErrNoCover},
+	} {
+		got, err := c.MinimizeWithout(test.excluded...)
+		if err != test.err || len(got) != len(test.want) || !allMatch(got, test.want) {
+			t.Errorf("MinimizeWithout(%v): got %v, %v; want %v, %v", test.excluded, got, err, test.want, test.err)
+		}
+	}
+	// B and D are essential once C is excluded, but not in c.
+	if got, err := c.MinimizeWithout("C"); err != nil || len(got) != 1 || !allMatch(got, [][]Subset{{"B", "D", "E"}}) {
+		t.Errorf("MinimizeWithout(C): got %v, %v; want [[B D E]], nil", got, err)
+	}
+	if ess := c.Essential(); ess != nil {
+		t.Errorf("MinimizeWithout: got Essential %v, want nil", ess)
+	}
+
+	for name, test := range coverTests {
+		c := test.c.Clone()
+		if got, err := c.MinimizeWithout(); err != nil || len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("MinimizeWithout(%v): got %v, %v; want %v, nil", name, got, err, test.min)
+		}
+		if !reflect.DeepEqual(c, test.c) {
+			t.Errorf("MinimizeWithout(%v): modified Cover to %+v", name, c)
+		}
+	}
+}
+
+func TestMinimizeCore(t *testing.T) {
+	for name, test := range coverTests {
+		c := test.c.Clone()
+		cores := c.MinimizeCore()
+		ess := c.Essential()
+		if test.simok && (len(cores) != 1 || len(cores[0]) != 0) {
+			t.Errorf("MinimizeCore(%v): got %v, want [[]]", name, cores)
+		}
+		var got [][]Subset
+		for _, cs := range cores {
+			got = append(got, append(cs, ess...))
+		}
+		if len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("MinimizeCore(%v): got %v with essential Subsets %v, want %v", name, cores, ess, test.min)
+		}
+	}
+}
+
+func TestAllCovers(t *testing.T) {
+	for name, test := range coverTests {
+		// Minimize does not return covering sets that contain dominated Subsets, but AllCovers does.
+		n := len(test.min[0])
+		got := test.c.AllCovers(n)
+		if len(got) < len(test.min) || !allMatch(test.min, got) {
+			t.Errorf("AllCovers(%v, %d): got %v, want a superset of %v", name, n, got, test.min)
+		}
+		for _, cs := range got {
+			if len(cs) != n || !test.c.IsCover(cs) {
+				t.Errorf("AllCovers(%v, %d): got %v, not a covering set of length %d", name, n, cs, n)
+			}
+		}
+		if got := test.c.AllCovers(n - 1); got != nil {
+			t.Errorf("AllCovers(%v, %d): got %v, want nil", name, n-1, got)
+		}
+	}
+
+	// Compare with every combination of Subsets that IsCover accepts.
+	for seed := int64(0); seed < 5; seed++ {
+		c := Generate(seed, 10, 8, 0.3)
+		ss := subsets(c.in)
+		k := c.MinSize() + 2
+		var want [][]Subset
+		for mask := 0; mask < 1<<len(ss); mask++ {
+			var cs []Subset
+			for i, s := range ss {
+				if mask&(1<<i) != 0 {
+					cs = append(cs, s)
+				}
+			}
+			if len(cs) <= k && c.IsCover(cs) {
+				want = append(want, cs)
+			}
+		}
+		got := c.AllCovers(k)
+		if len(got) != len(want) || !allMatch(got, want) {
+			t.Errorf("AllCovers(random %d, %d): got %d covering sets, want %d", seed, k, len(got), len(want))
+		}
+		for i := 1; i < len(got); i++ {
+			if len(got[i]) < len(got[i-1]) {
+				t.Errorf("AllCovers(random %d, %d): %v precedes %v", seed, k, got[i-1], got[i])
+			}
+		}
+	}
+
+	if got := New().AllCovers(0); len(got) != 1 || len(got[0]) != 0 {
+		t.Errorf("AllCovers(empty, 0): got %v, want [[]]", got)
+	}
+}
+
+func TestIsUnique(t *testing.T) {
+	for name, test := range coverTests {
+		if got, want := test.c.IsUnique(), len(test.min) == 1; got != want {
+			t.Errorf("IsUnique(%v): got %v, want %v", name, got, want)
+		}
+	}
+	for seed := int64(0); seed < 10; seed++ {
+		c := Generate(seed, 12, 10, 0.3)
+		if got, want := c.IsUnique(), len(c.Clone().Minimize()) == 1; got != want {
+			t.Errorf("IsUnique(random %d): got %v, want %v", seed, got, want)
+		}
+	}
+}
+
+func TestCovered(t *testing.T) {
+	c := New()
+	c.Add("A", 1, 2)
+	c.Add("B", 2, 3)
+	c.Add("C", 3, 4)
+	for _, test := range []struct {
+		ss        []Subset
+		covered   map[Element]struct{}
+		uncovered []Element
+	}{
+		{nil, map[Element]struct{}{}, []Element{1, 2, 3, 4}},
+		{[]Subset{"A"}, map[Element]struct{}{1: {}, 2: {}}, []Element{3, 4}},
+		{[]Subset{"B", "D"}, map[Element]struct{}{2: {}, 3: {}}, []Element{1, 4}},
+		{[]Subset{"A", "C"}, map[Element]struct{}{1: {}, 2: {}, 3: {}, 4: {}}, nil},
+	} {
+		if got := c.Covered(test.ss); !reflect.DeepEqual(got, test.covered) {
+			t.Errorf("Covered(%v): got %v, want %v", test.ss, got, test.covered)
+		}
+		if got := c.Uncovered(test.ss); !reflect.DeepEqual(got, test.uncovered) {
+			t.Errorf("Uncovered(%v): got %v, want %v", test.ss, got, test.uncovered)
+		}
+	}
+}
+
+func TestRedundantIn(t *testing.T) {
+	c := New()
+	c.Add("A", 1, 2)
+	c.Add("B", 2, 3)
+	c.Add("C", 3, 4)
+	c.Add("D", 4, 1)
+	for _, test := range []struct {
+		cover, want []Subset
+	}{
+		{[]Subset{"A", "C"}, nil},
+		{[]Subset{"A", "B", "C"}, []Subset{"B"}},
+		{[]Subset{"D", "A", "B", "C"}, []Subset{"D", "A", "B", "C"}},
+		{[]Subset{"A", "C", "E"}, []Subset{"E"}},
+		{[]Subset{"A", "C", "A"}, []Subset{"A", "A"}},
+		{[]Subset{"A", "B"}, nil},
+		{nil, nil},
+	} {
+		if got := c.RedundantIn(test.cover); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("RedundantIn(%v): got %v, want %v", test.cover, got, test.want)
+		}
+	}
+
+	// Minimum covering sets are irredundant.
+	for name, test := range coverTests {
+		for _, cs := range test.min {
+			if got := test.c.RedundantIn(cs); got != nil {
+				t.Errorf("RedundantIn(%v, %v): got %v, want nil", name, cs, got)
+			}
+		}
+	}
+}
+
+func TestMakeIrredundant(t *testing.T) {
+	c := New()
+	c.Add("A", 1, 2)
+	c.Add("B", 2, 3)
+	c.Add("C", 3, 4)
+	c.Add("D", 4, 1)
+	for _, test := range []struct {
+		cover, want []Subset
+	}{
+		{[]Subset{"A", "C"}, []Subset{"A", "C"}},
+		{[]Subset{"A", "B", "C"}, []Subset{"A", "C"}},
+		{[]Subset{"D", "C", "B", "A"}, []Subset{"D", "B"}},
+		{[]Subset{"C", "E", "A", "C"}, []Subset{"A", "C"}},
+		{[]Subset{"A", "B"}, []Subset{"A", "B"}},
+		{[]Subset{"A", "A"}, []Subset{"A"}},
+		{nil, nil},
+	} {
+		got := c.MakeIrredundant(test.cover)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("MakeIrredundant(%v): got %v, want %v", test.cover, got, test.want)
+		}
+		if c.IsCover(test.cover) && (!c.IsCover(got) || c.RedundantIn(got) != nil) {
+			t.Errorf("MakeIrredundant(%v): got %v, not an irredundant covering set", test.cover, got)
+		}
+	}
+
+	for seed := int64(0); seed < 10; seed++ {
+		c := Generate(seed, 20, 20, 0.2)
+		all := subsets(c.in)
+		got := c.MakeIrredundant(all)
+		if !c.IsCover(got) || c.RedundantIn(got) != nil {
+			t.Errorf("MakeIrredundant(random %d): got %v, not an irredundant covering set", seed, got)
+		}
+	}
+}
+
+func TestMinimizeSeq(t *testing.T) {
+	for name, test := range coverTests {
+		c := test.c.Clone()
+		var got [][]Subset
+		for cs := range c.MinimizeSeq() {
+			got = append(got, cs)
+		}
+		if len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("MinimizeSeq(%v): got %v, want %v", name, got, test.min)
+		}
+		if !reflect.DeepEqual(c, test.c) {
+			t.Errorf("MinimizeSeq(%v): modified Cover to %+v", name, c)
+		}
+
+		var n int
+		for range c.MinimizeSeq() {
+			n++
+			break
+		}
+		if n != 1 {
+			t.Errorf("MinimizeSeq(%v): got %d covering sets before break, want 1", name, n)
+		}
+	}
+}
+
+func TestReduceC(t *testing.T) {
+	// Every Subset containing x, y, or z contains another of them, and w is contained by the same Subsets as y.
+	c := New()
+	c.Add("A", "x", "y", "w")
+	c.Add("B", "y", "z", "w")
+	c.Add("C", "z", "x")
+	c.m = bipartite.Copy(c.in)
+	want := &Cover{
+		in: bipartite.Copy(c.in),
+		m: fromInputs(
+			input{"A", []Element{"x", "w"}},
+			input{"B", []Element{"z", "w"}},
+			input{"C", []Element{"z", "x"}},
+		),
+		essential: smap(),
+	}
+	if ok := c.reduceC(); !ok || !reflect.DeepEqual(c, want) {
+		t.Errorf("reduceC: got %+v, %v; want %+v, true", c, ok, want)
+	}
+	if c.reduceC() {
+		t.Errorf("reduceC: got true on second call")
+	}
+
+	// Removing dominated Elements shrinks the cyclic core.
+	c.m = bipartite.Copy(c.in)
+	if c.simplify() || c.m.NB() != 3 {
+		t.Errorf("simplify: got %d Elements in the cyclic core, want 3", c.m.NB())
+	}
 
-				essential: smap(),
-			},
-		},
-		// Subsets containing the same Element
-		{
-			[]input{
-				{"Powers of 2", []Element{2}},
-				{"Even primes", []Element{2}},
-			},
-			&Cover{
-				in: fromInputs(
-					input{"Powers of 2", []Element{2}},
-					input{"Even primes", []Element{2}},
-				),
-				m: bipartite.New(),
+	// It does not change the covering sets. Once Subset 3 is removed, Element 1 implies 0 and 3,
+	// 4 implies 5, and 6 implies 2. Removing them would leave Subset 4 dominated by 2,
+	// but [1 4] is a minimum covering set.
+	c = New()
+	c.Add(0, 0, 1, 3, 4, 5)
+	c.Add(1, 0, 1, 2, 3, 6)
+	c.Add(2, 0, 2, 4, 5, 6)
+	c.Add(3, 2, 4, 5, 6)
+	c.Add(4, 0, 2, 3, 4, 5)
+	if got, want := c.Minimize(), [][]Subset{{0, 1}, {0, 2}, {1, 2}, {1, 4}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Minimize(%v): got %v, want %v", c, got, want)
+	}
+	for seed := int64(0); seed < 3000; seed++ {
+		c := Generate(seed, 5, 7, 0.6)
+		want := minimizeWithoutReduceC(c)
+		if got := c.Minimize(); len(got) != len(want) || !allMatch(got, want) {
+			t.Errorf("Minimize(%v): got %v, want %v", c, got, want)
+		}
+	}
+}
 
-				essential: smap(),
-			},
-		},
-		// Partial overlap
-		{
-			[]input{
-				{"Powers of 2", []Element{1, 2, 4, 8}},
-				{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8}},
-			},
-			&Cover{
-				in: fromInputs(
-					input{"Powers of 2", []Element{1, 2, 4, 8}},
-					input{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8}},
-				),
-				m: bipartite.New(),
+// minimizeWithoutReduceC returns the covering sets that Minimize finds if it does not remove dominated Elements,
+// searching the cyclic core by bruteForce.
+func minimizeWithoutReduceC(c *Cover) [][]Subset {
+	s := &Cover{
+		in: c.in,
+		m:  bipartite.Copy(c.in),
 
-				essential: smap(),
-			},
-		},
-		// Add empty Subset to populated Cover
-		{
-			[]input{
-				{"Powers of 2", []Element{1, 2, 4, 8}},
-				{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8}},
-				{"Odd perfect numbers", []Element{}},
-			},
-			&Cover{
-				in: fromInputs(
-					input{"Powers of 2", []Element{1, 2, 4, 8}},
-					input{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8}},
-				),
-				m: bipartite.New(),
+		essential: make(sset),
+	}
+	s.reduceS()
+	for s.reduceE() && s.reduceS() {
+	}
+	var ess []Subset
+	for a := range s.essential {
+		ess = append(ess, a)
+	}
+	if s.m.NB() == 0 {
+		return [][]Subset{ess}
+	}
+	covers, _ := s.bruteForce(ess)
+	return covers
+}
 
-				essential: smap(),
-			},
-		},
-		// Add additional Elements of a Subset
-		{
-			[]input{
-				{"Powers of 2", []Element{1, 2, 4, 8}},
-				{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8}},
-				{"Fibonacci numbers", []Element{13}},
-			},
-			&Cover{
-				in: fromInputs(
-					input{"Powers of 2", []Element{1, 2, 4, 8}},
-					input{"Fibonacci numbers", []Element{0, 1, 2, 3, 5, 8, 13}},
-				),
-				m: bipartite.New(),
+func TestMinimize(t *testing.T) {
+	for name, test := range coverTests {
+		c := test.c.Clone()
+		// got and test.want must have identical contents, possibly in different orders.
+		if got := c.Minimize(); len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("Minimize(%v): got %v, want %v", name, got, test.min)
+		}
+	}
+}
 
-				essential: smap(),
-			},
-		},
-	} {
-		c := New()
-		for _, in := range test.ins {
-			c.Add(in.s, in.es...)
+func TestTranspose(t *testing.T) {
+	c := New()
+	c.Add("A", 1, 2)
+	c.AddWithCost("B", 2, 2, 3)
+	c.Minimize()
+	want := New()
+	want.Add(1, "A")
+	want.Add(2, "A", "B")
+	want.Add(3, "B")
+	if got := c.Transpose(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Transpose(%v): got %v, want %v", c, got, want)
+	}
+
+	for name, test := range coverTests {
+		if got := test.c.Transpose().Transpose(); !got.Equal(test.c) {
+			t.Errorf("Transpose(Transpose(%v)): got %v", name, got)
 		}
-		if !reflect.DeepEqual(c, test.want) {
-			t.Errorf("Add(%+v): got %+v, want %+v", test.ins, c, test.want)
+	}
+}
+
+func TestComplement(t *testing.T) {
+	c := New()
+	c.Add("A", 1, 2)
+	c.AddWithCost("B", 3, 2, 3, 5)
+	c.Add("C", 1, 2, 3, 4)
+	got := c.Complement([]Element{1, 2, 3, 4})
+	want := New()
+	want.Add("A", 3, 4)
+	want.AddWithCost("B", 3, 1, 4)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Complement(%v): got %v, want %v", c, got, want)
+	}
+	if got := c.Complement(nil); got.NumSubsets() != 0 {
+		t.Errorf("Complement(%v, nil): got %v, want empty", c, got)
+	}
+
+	// The complement of the complement over the same universe is the original
+	// without the Subsets that contain every Element.
+	for name, test := range coverTests {
+		u := elements(test.c.in)
+		want := New()
+		for _, s := range subsets(test.c.in) {
+			if es := adjToA(test.c.in, s); len(es) < len(u) {
+				want.Add(s, es...)
+			}
+		}
+		if got := test.c.Complement(u).Complement(u); !got.Equal(want) {
+			t.Errorf("Complement(Complement(%v)): got %v, want %v", name, got, want)
 		}
 	}
 }
 
-func TestDominates(t *testing.T) {
+func TestRestrictTo(t *testing.T) {
+	c := New()
+	c.Add("A", 1, 2, 3)
+	c.AddWithCost("B", 2, 3, 4)
+	c.Add("C", 4, 5)
+	c.Add("D", 5)
+	want := c.Clone()
+	r := c.RestrictTo([]Element{1, 3, 4, 6, 3})
+	if !reflect.DeepEqual(c, want) {
+		t.Errorf("RestrictTo: modified receiver to %v", c)
+	}
+	if got, want := r.String(), "A: {1, 3}\nB: {3, 4}\nC: {4}"; got != want {
+		t.Errorf("RestrictTo: got %q, want %q", got, want)
+	}
+	if got := r.Cost("B"); got != 2 {
+		t.Errorf("RestrictTo: got cost %v for B, want 2", got)
+	}
+	if got, want := r.Minimize(), [][]Subset{{"A", "B"}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("RestrictTo: Minimize got %v, want %v", got, want)
+	}
+
+	// Restricting to every Element gives an equal Cover.
+	for name, test := range coverTests {
+		if got := test.c.RestrictTo(elements(test.c.in)); !got.Equal(test.c) {
+			t.Errorf("RestrictTo(%v, all Elements): got %v", name, got)
+		}
+	}
+}
+
+func TestMinHittingSet(t *testing.T) {
+	// Every pair of Subsets shares an Element, and no Element is in all three.
+	c := New()
+	c.Add("A", 1, 2)
+	c.Add("B", 2, 3)
+	c.Add("C", 1, 3)
 	for _, test := range []struct {
-		c   *Cover
-		dom map[Subset]sset
+		add  Subset
+		want [][]Element
 	}{
-		{
-			&Cover{
-				in: fromInputs(input{true, []Element{true}}),
-				m:  fromInputs(input{true, []Element{true}}),
-
-				essential: smap(),
-			},
-			map[Subset]sset{},
-		},
-		{
-			&Cover{
-				in: fromInputs(
-					input{"A", []Element{"x"}},
-					input{"B", []Element{"y"}},
-				),
-				m: fromInputs(
-					input{"A", []Element{"x"}},
-					input{"B", []Element{"y"}},
-				),
-				essential: smap(),
-			},
-			map[Subset]sset{},
-		},
-		{
-			&Cover{
-				in: fromInputs(
-					input{"A", []Element{"x"}},
-					input{"B", []Element{"x", "y", "z"}},
-				),
-				m: fromInputs(
-					input{"A", []Element{"x"}},
-					input{"B", []Element{"x", "y", "z"}},
-				),
-				essential: smap(),
-			},
-			map[Subset]sset{"B": smap("A")},
-		},
-		{
-			&Cover{
-				in: fromInputs(
-					input{"A", []Element{2}},
-					input{"B", []Element{2, 6}},
-					input{"C", []Element{2, 6}},
-					input{"D", []Element{1, 2, 4}},
-					input{"E", []Element{3, 5, 7}},
-					input{"F", []Element{0, 1, 2, 4, 7}},
-				),
-				m: fromInputs(
-					input{"A", []Element{2}},
-					input{"B", []Element{2, 6}},
-					input{"C", []Element{2, 6}},
-					input{"D", []Element{1, 2, 4}},
-					input{"E", []Element{3, 5, 7}},
-					input{"F", []Element{0, 1, 2, 4, 7}},
-				),
-				essential: smap(),
-			},
-			map[Subset]sset{
-				"B": smap("A"),
-				"C": smap("A"),
-				"D": smap("A"),
-				"F": smap("A", "D"),
-			},
-		},
+		{nil, [][]Element{{1, 2}, {1, 3}, {2, 3}}},
+		{"D", [][]Element{{1, 2}, {2, 3}}},
 	} {
-		for a := range test.c.m.As() {
-			for b := range test.c.m.As() {
-				_, want := test.dom[a][b]
-				if got := test.c.dominates(a, b); got != want {
-					t.Errorf("dominates(%+v, %v, %v): got %v, want %v", test.c, a, b, got, want)
-				}
-			}
+		if test.add != nil {
+			c.Add(test.add, 2)
+		}
+		got := c.MinHittingSet()
+		for _, es := range got {
+			sortBySprint(es)
+		}
+		sortBySprint(got)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("MinHittingSet(%v): got %v, want %v", c, got, test.want)
 		}
 	}
 }
 
-// copy copies the information in c into a new Cover and returns a pointer to it.
-// The returned cover is deeply equal to c but shares no memory with it.
-func (c *Cover) copy() *Cover {
-	return &Cover{
-		in: bipartite.Copy(c.in),
-		m:  bipartite.Copy(c.m),
+func TestMinimizeFull(t *testing.T) {
+	c := New()
+	c.Add("A", 1, 2, 3)
+	c.Add("B", 1, 2)
+	c.Add("C", 3)
+	if got, want := c.Minimize(), [][]Subset{{"A"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Minimize(%v): got %v, want %v", c, got, want)
+	}
+	if got, want := c.Essential(), []Subset{"A"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Essential(%v): got %v, want %v", c, got, want)
+	}
 
-		essential: c.essential.copy(),
+	c.Add("D", 3, 2, 1)
+	if got, want := c.Minimize(), [][]Subset{{"A"}, {"D"}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("Minimize(%v): got %v, want %v", c, got, want)
+	}
+	if got := c.Essential(); got != nil {
+		t.Errorf("Essential(%v): got %v, want nil", c, got)
 	}
 }
 
-func TestCopy(t *testing.T) {
+func TestIsCover(t *testing.T) {
 	for name, test := range coverTests {
-		for _, c := range []*Cover{test.c, test.s, test.e, test.sim} {
-			if got := c.copy(); !reflect.DeepEqual(c, got) {
-				t.Errorf("copy(%v, %#v): got %#v", name, c, got)
+		for _, cs := range test.min {
+			if !test.c.IsCover(cs) {
+				t.Errorf("IsCover(%v, %v): got false", name, cs)
+			}
+			if len(cs) > 0 && test.c.IsCover(cs[1:]) {
+				t.Errorf("IsCover(%v, %v): got true", name, cs[1:])
+			}
+			if !test.c.IsCover(append([]Subset{"unknown"}, cs...)) {
+				t.Errorf("IsCover(%v, %v + unknown): got false", name, cs)
 			}
 		}
 	}
 }
 
-func TestReduceS(t *testing.T) {
+func TestEssential(t *testing.T) {
 	for name, test := range coverTests {
-		got := test.c.copy()
-		if gotok := got.reduceS(); gotok != test.sok || !reflect.DeepEqual(got, test.s) {
-			t.Errorf("reduceS(%v): got %+v, %v; want %+v, %v", name, got, gotok, test.s, test.sok)
+		c := test.c.Clone()
+		if got := c.Essential(); got != nil {
+			t.Errorf("Essential(%v) before Minimize: got %v, want nil", name, got)
+		}
+		c.Minimize()
+		if got := c.Essential(); !reflect.DeepEqual(smap(got...), test.sim.essential) {
+			t.Errorf("Essential(%v): got %v, want %v", name, got, test.sim.essential)
 		}
 	}
 }
 
-func TestReduceE(t *testing.T) {
+func TestMinSize(t *testing.T) {
 	for name, test := range coverTests {
-		got := test.c.copy()
-		if gotok := got.reduceE(); gotok != test.eok || !reflect.DeepEqual(got, test.e) {
-			t.Errorf("reduceE(%v): got %+v, %v; want %+v, %v", name, got, gotok, test.e, test.eok)
+		c := test.c.Clone()
+		if got, want := c.MinSize(), len(test.min[0]); got != want {
+			t.Errorf("MinSize(%v): got %d, want %d", name, got, want)
+		}
+		if !reflect.DeepEqual(c, test.c) {
+			t.Errorf("MinSize(%v): modified Cover to %+v", name, c)
 		}
 	}
 }
 
-func TestSimplify(t *testing.T) {
+func TestIsMinimum(t *testing.T) {
 	for name, test := range coverTests {
-		got := test.c.copy()
-		if gotok := got.simplify(); gotok != test.simok || !reflect.DeepEqual(got, test.sim) {
-			t.Errorf("simplify(%v): got %+v, %v; want %+v, %v", name, got, gotok, test.sim, test.simok)
+		c := test.c.Clone()
+		for _, cs := range test.min {
+			if !c.IsMinimum(cs) {
+				t.Errorf("IsMinimum(%v, %v): got false, want true", name, cs)
+			}
+			if len(cs) > 0 && c.IsMinimum(cs[1:]) {
+				t.Errorf("IsMinimum(%v, %v): got true for a non-covering set", name, cs[1:])
+			}
+		}
+		if all := subsets(c.in); len(all) > len(test.min[0]) && c.IsMinimum(all) {
+			t.Errorf("IsMinimum(%v, %v): got true for a covering set longer than minimum", name, all)
+		}
+		if !reflect.DeepEqual(c, test.c) {
+			t.Errorf("IsMinimum(%v): modified Cover to %+v", name, c)
 		}
 	}
 }
 
-func TestMinimize(t *testing.T) {
+func TestLowerBound(t *testing.T) {
 	for name, test := range coverTests {
-		c := test.c.copy()
-		// got and test.want must have identical contents, possibly in different orders.
-		if got := c.Minimize(); len(got) != len(test.min) || !allMatch(got, test.min) {
-			t.Errorf("Minimize(%v): got %v, want %v", name, got, test.min)
+		c := test.c.Clone()
+		// The bound is tight for every case in coverTests.
+		if got, want := c.LowerBound(), float64(len(test.min[0])); got != want {
+			t.Errorf("LowerBound(%v): got %v, want %v", name, got, want)
+		}
+		if !reflect.DeepEqual(c, test.c) {
+			t.Errorf("LowerBound(%v): modified Cover to %+v", name, c)
+		}
+	}
+	// Three Subsets pairwise share one of three Elements: the bound is 2, and so is the minimum.
+	c := New()
+	c.Add("A", "x", "y")
+	c.Add("B", "y", "z")
+	c.Add("C", "x", "z")
+	if got := c.LowerBound(); got != 2 {
+		t.Errorf("LowerBound(triangle): got %v, want 2", got)
+	}
+}
+
+func TestNextPerm(t *testing.T) {
+	for _, test := range []struct {
+		b    []bool
+		want [][]bool
+	}{
+		{[]bool{}, nil},
+		{[]bool{false}, nil},
+		{[]bool{true}, nil},
+		{[]bool{false, false}, nil},
+		{[]bool{true, true}, nil},
+		{[]bool{true, false}, [][]bool{{false, true}}},
+		{[]bool{true, false, false}, [][]bool{{false, true, false}, {false, false, true}}},
+		{[]bool{true, true, false}, [][]bool{{true, false, true}, {false, true, true}}},
+	} {
+		b := append([]bool(nil), test.b...)
+		var got [][]bool
+		for nextPerm(b) {
+			got = append(got, append([]bool(nil), b...))
+			if len(got) > len(test.want) {
+				break
+			}
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("nextPerm(%v): got %v, want %v", test.b, got, test.want)
 		}
 	}
 }
@@ -353,7 +2044,7 @@ func allMatch(a, b [][]Subset) bool {
 }
 
 var coverTests = map[string]struct {
-	// The input Cover. Do not mutate: use copy() and call methods on the copy.
+	// The input Cover. Do not mutate: use Clone() and call methods on the clone.
 	c *Cover
 
 	// 	Cover after reduceS, reduceE, and simplify
@@ -1020,3 +2711,60 @@ var coverTests = map[string]struct {
 		},
 	},
 }
+
+func BenchmarkReduceC(b *testing.B) {
+	c := Generate(1, 40, 300, 0.2)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.m = bipartite.Copy(c.in)
+		c.reduceC()
+	}
+}
+
+func FuzzMinimize(f *testing.F) {
+	// Each byte adds an incidence of one of 8 Subsets and one of 16 Elements.
+	// A byte with its high bit set adds an isolated Subset with no Elements, which is a no-op.
+	f.Add([]byte{})
+	f.Add([]byte{0x00})
+	f.Add([]byte{0x00, 0x10, 0x20})
+	f.Add([]byte{0x00, 0x01, 0x02, 0x10, 0x11, 0x12})
+	f.Add([]byte{0x01, 0x12, 0x23, 0x30, 0x80, 0x9f})
+	f.Add([]byte{0x00, 0x01, 0x11, 0x12, 0x22, 0x23, 0x33, 0x30, 0x45, 0x56, 0x64, 0x77})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		c := New()
+		for _, b := range data {
+			s := int(b >> 4 & 7)
+			if b&0x80 != 0 {
+				c.Add(s)
+				continue
+			}
+			c.Add(s, int(b&15))
+		}
+
+		covers := c.Clone().Minimize()
+		if len(covers) == 0 {
+			t.Fatalf("Minimize(%v): got no covering sets", c)
+		}
+		seen := make(map[string]bool)
+		for _, cs := range covers {
+			if !c.IsCover(cs) {
+				t.Errorf("Minimize(%v): got %v, not a covering set", c, cs)
+			}
+			if len(cs) != len(covers[0]) {
+				t.Errorf("Minimize(%v): got covering sets of lengths %d and %d", c, len(covers[0]), len(cs))
+			}
+			sortBySprint(cs)
+			if k := fmt.Sprint(cs); seen[k] {
+				t.Errorf("Minimize(%v): got %v more than once", c, cs)
+			} else {
+				seen[k] = true
+			}
+		}
+		if n := c.MinSize(); n != len(covers[0]) {
+			t.Errorf("Minimize(%v): got covering sets of length %d, want %d", c, len(covers[0]), n)
+		}
+		if got := c.Clone().MinimizeBB(); len(got) != len(covers) || !allMatch(got, covers) {
+			t.Errorf("MinimizeBB(%v): got %v, want %v", c, got, covers)
+		}
+	})
+}
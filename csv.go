@@ -0,0 +1,29 @@
+package cover
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// WriteCSV writes covers to w as comma-separated values, one record per covering set.
+// Each record holds the index of the covering set in covers
+// followed by the fmt.Sprint representation of each of its Subsets, in order.
+// The covering sets returned by Minimize all have the same length, but those of different lengths
+// produce records with different numbers of fields; WriteCSV does not pad them.
+func WriteCSV(w io.Writer, covers [][]Subset) error {
+	cw := csv.NewWriter(w)
+	var record []string
+	for i, cs := range covers {
+		record = append(record[:0], strconv.Itoa(i))
+		for _, s := range cs {
+			record = append(record, fmt.Sprint(s))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
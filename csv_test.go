@@ -0,0 +1,39 @@
+package cover
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWriteCSV(t *testing.T) {
+	for _, test := range []struct {
+		covers [][]Subset
+		want   string
+	}{
+		{nil, ""},
+		{[][]Subset{{}}, "0\n"},
+		{[][]Subset{{"A", 1}, {"B", "a,b"}, {`"q"`}}, "0,A,1\n1,B,\"a,b\"\n2,\"\"\"q\"\"\"\n"},
+	} {
+		var b strings.Builder
+		if err := WriteCSV(&b, test.covers); err != nil {
+			t.Errorf("WriteCSV(%v): %v", test.covers, err)
+		}
+		if got := b.String(); got != test.want {
+			t.Errorf("WriteCSV(%v): got %q, want %q", test.covers, got, test.want)
+		}
+	}
+}
+
+// errWriter is an io.Writer that always fails.
+type errWriter struct{}
+
+var errWrite = errors.New("write failed")
+
+func (errWriter) Write([]byte) (int, error) { return 0, errWrite }
+
+func TestWriteCSVError(t *testing.T) {
+	if err := WriteCSV(errWriter{}, [][]Subset{{"A"}}); !errors.Is(err, errWrite) {
+		t.Errorf("WriteCSV: got error %v, want %v", err, errWrite)
+	}
+}
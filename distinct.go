@@ -0,0 +1,60 @@
+package cover
+
+import (
+	"fmt"
+	"sort"
+)
+
+// MinimizeDistinct returns the minimum-length covering sets that Minimize returns,
+// with those that differ only by the exchange of interchangeable Subsets collapsed into one.
+// equiv reports whether two Subsets are interchangeable, and must be an equivalence relation.
+//
+// Each Subset is represented by the Subset equivalent to it that sorts first by its fmt.Sprint
+// representation, and two covering sets are equivalent if they have the same representatives.
+// MinimizeDistinct returns the covering set of each equivalence class that sorts first,
+// comparing the fmt.Sprint representations of their Subsets, each of which is sorted,
+// so that the result does not depend on the order of the covering sets that Minimize returns.
+// The covering sets are returned in the same order.
+// equiv is called without c locked, so it may call methods of c.
+func (c *Cover) MinimizeDistinct(equiv func(a, b Subset) bool) [][]Subset {
+	c.mu.Lock()
+	covers := c.minimize()
+	ss := subsets(c.in)
+	c.mu.Unlock()
+
+	sortBySprint(ss)
+	class := make(map[Subset]int, len(ss))
+	var reps []Subset
+	for _, s := range ss {
+		class[s] = len(reps)
+		for i, r := range reps {
+			if equiv(r, s) {
+				class[s] = i
+				break
+			}
+		}
+		if class[s] == len(reps) {
+			reps = append(reps, s)
+		}
+	}
+
+	for _, cs := range covers {
+		sortBySprint(cs)
+	}
+	sortBySprint(covers)
+
+	seen := make(map[string]bool)
+	var distinct [][]Subset
+	for _, cs := range covers {
+		idx := make([]int, len(cs))
+		for i, s := range cs {
+			idx[i] = class[s]
+		}
+		sort.Ints(idx)
+		if k := fmt.Sprint(idx); !seen[k] {
+			seen[k] = true
+			distinct = append(distinct, cs)
+		}
+	}
+	return distinct
+}
@@ -0,0 +1,40 @@
+package cover
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMinimizeDistinct(t *testing.T) {
+	// A and B contain the same Elements, so each minimum covering set contains one of them.
+	c := New()
+	c.Add("A", 1, 2)
+	c.Add("B", 1, 2)
+	c.Add("C", 3)
+	equiv := func(a, b Subset) bool {
+		return a == b || a == "A" && b == "B" || a == "B" && b == "A"
+	}
+	if got := c.Clone().Minimize(); len(got) != 2 {
+		t.Fatalf("Minimize(%v): got %v, want 2 covering sets", c, got)
+	}
+	want := [][]Subset{{"A", "C"}}
+	for i := 0; i < 10; i++ {
+		if got := c.Clone().MinimizeDistinct(equiv); !reflect.DeepEqual(got, want) {
+			t.Errorf("MinimizeDistinct(%v): got %v, want %v", c, got, want)
+		}
+	}
+
+	// equiv may call methods of c.
+	sameSize := func(a, b Subset) bool { return c.Size(a) == c.Size(b) }
+	if got := c.MinimizeDistinct(sameSize); !reflect.DeepEqual(got, want) {
+		t.Errorf("MinimizeDistinct(%v) by Size: got %v, want %v", c, got, want)
+	}
+
+	// If no two Subsets are interchangeable, the result is that of Minimize.
+	same := func(a, b Subset) bool { return a == b }
+	for name, test := range coverTests {
+		if got := test.c.Clone().MinimizeDistinct(same); len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("MinimizeDistinct(%v): got %v, want %v", name, got, test.min)
+		}
+	}
+}
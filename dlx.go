@@ -0,0 +1,137 @@
+package cover
+
+// ExactCoversDLX returns the same exact covers as ExactCovers using Knuth's Algorithm X
+// implemented with dancing links, which is faster for large problems.
+// At each step it branches on the Element contained by the fewest remaining Subsets.
+func (c *Cover) ExactCoversDLX() [][]Subset {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	t := newTable(c.in, subsets(c.in))
+	d := newDLX(t)
+	d.search()
+	return d.covers
+}
+
+// dlx holds the toroidal doubly linked lists of Algorithm X.
+// The links are indices into its slices rather than pointers.
+// Node 0 is the root, nodes 1 through len(t.es) are the column headers of the Elements,
+// and each remaining node represents the containment of an Element by a Subset.
+type dlx struct {
+	t *table
+
+	// l, r, u, and d hold the indices of each node's left, right, up, and down neighbors.
+	l, r, u, d []int
+
+	// col holds the index of each node's column header.
+	col []int
+
+	// row holds the index in t.ss of each non-header node's Subset.
+	row []int
+
+	// size holds the number of nodes in each column.
+	size []int
+
+	// chosen holds the indices of the nodes of the rows chosen along the current branch.
+	chosen []int
+
+	// covers holds the exact covers found so far.
+	covers [][]Subset
+}
+
+// newDLX returns a dlx representing the Subsets and Elements of t.
+func newDLX(t *table) *dlx {
+	m := len(t.es)
+	d := &dlx{t: t, size: make([]int, m+1)}
+	for i := 0; i <= m; i++ {
+		d.newNode(i, -1)
+		d.l[i], d.r[i] = (i+m)%(m+1), (i+1)%(m+1)
+	}
+	for i, cov := range t.cov {
+		first := -1
+		for e := cov.next(0); e >= 0; e = cov.next(e + 1) {
+			col := e + 1
+			n := d.newNode(col, i)
+			// Link n at the bottom of its column.
+			d.u[n], d.d[n] = d.u[col], col
+			d.d[d.u[col]], d.u[col] = n, n
+			d.size[col]++
+			// Link n at the end of its row.
+			if first == -1 {
+				first = n
+			} else {
+				d.l[n], d.r[n] = d.l[first], first
+				d.r[d.l[first]], d.l[first] = n, n
+			}
+		}
+	}
+	return d
+}
+
+// newNode appends a node in column col representing a containment by the Subset t.ss[row]
+// and returns its index. The node is initially linked only to itself.
+func (d *dlx) newNode(col, row int) int {
+	n := len(d.col)
+	d.l, d.r = append(d.l, n), append(d.r, n)
+	d.u, d.d = append(d.u, n), append(d.d, n)
+	d.col, d.row = append(d.col, col), append(d.row, row)
+	return n
+}
+
+// cover removes column c from the header list and removes every row in it from the other columns.
+func (d *dlx) cover(c int) {
+	d.r[d.l[c]], d.l[d.r[c]] = d.r[c], d.l[c]
+	for i := d.d[c]; i != c; i = d.d[i] {
+		for j := d.r[i]; j != i; j = d.r[j] {
+			d.d[d.u[j]], d.u[d.d[j]] = d.d[j], d.u[j]
+			d.size[d.col[j]]--
+		}
+	}
+}
+
+// uncover reverses cover(c).
+func (d *dlx) uncover(c int) {
+	for i := d.u[c]; i != c; i = d.u[i] {
+		for j := d.l[i]; j != i; j = d.l[j] {
+			d.size[d.col[j]]++
+			d.d[d.u[j]], d.u[d.d[j]] = j, j
+		}
+	}
+	d.r[d.l[c]], d.l[d.r[c]] = c, c
+}
+
+// search records every exact cover that extends the rows in d.chosen.
+func (d *dlx) search() {
+	if d.r[0] == 0 {
+		cs := make([]Subset, 0, len(d.chosen))
+		for _, n := range d.chosen {
+			cs = append(cs, d.t.ss[d.row[n]])
+		}
+		d.covers = append(d.covers, cs)
+		return
+	}
+
+	// Choose the column with the fewest rows.
+	c := d.r[0]
+	for j := d.r[c]; j != 0; j = d.r[j] {
+		if d.size[j] < d.size[c] {
+			c = j
+		}
+	}
+	if d.size[c] == 0 {
+		return
+	}
+
+	d.cover(c)
+	for i := d.d[c]; i != c; i = d.d[i] {
+		d.chosen = append(d.chosen, i)
+		for j := d.r[i]; j != i; j = d.r[j] {
+			d.cover(d.col[j])
+		}
+		d.search()
+		for j := d.l[i]; j != i; j = d.l[j] {
+			d.uncover(d.col[j])
+		}
+		d.chosen = d.chosen[:len(d.chosen)-1]
+	}
+	d.uncover(c)
+}
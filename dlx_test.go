@@ -0,0 +1,55 @@
+package cover
+
+import "testing"
+
+func TestExactCoversDLX(t *testing.T) {
+	knuth := New()
+	knuth.Add(1, "C", "E", "F")
+	knuth.Add(2, "A", "D", "G")
+	knuth.Add(3, "B", "C", "F")
+	knuth.Add(4, "A", "D")
+	knuth.Add(5, "B", "G")
+	knuth.Add(6, "D", "E", "G")
+
+	cs := map[string]*Cover{
+		"Knuth":        knuth,
+		"2×3 dominoes": tilings(2, 3, horizontalDomino, verticalDomino),
+		"4×5 dominoes": tilings(4, 5, horizontalDomino, verticalDomino),
+		"4×5 trominoes": tilings(4, 5,
+			[][2]int{{0, 0}, {1, 0}, {2, 0}},
+			[][2]int{{0, 0}, {0, 1}, {0, 2}},
+			[][2]int{{0, 0}, {1, 0}, {0, 1}},
+			[][2]int{{0, 0}, {1, 0}, {1, 1}},
+			[][2]int{{0, 0}, {0, 1}, {1, 1}},
+			[][2]int{{1, 0}, {0, 1}, {1, 1}},
+		),
+	}
+	for name, test := range coverTests {
+		cs[name] = test.c
+	}
+	for name, c := range cs {
+		want := c.ExactCovers()
+		if got := c.ExactCoversDLX(); len(got) != len(want) || !allMatch(got, want) {
+			t.Errorf("ExactCoversDLX(%v): got %v, want %v", name, got, want)
+		}
+	}
+
+	// A 4×5 board has 95 domino tilings.
+	if got := len(cs["4×5 dominoes"].ExactCoversDLX()); got != 95 {
+		t.Errorf("ExactCoversDLX(4×5 dominoes): got %d covers, want 95", got)
+	}
+}
+
+func BenchmarkExactCovers(b *testing.B) {
+	c := tilings(6, 6, horizontalDomino, verticalDomino)
+	b.Run("ExactCovers", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			c.ExactCovers()
+		}
+	})
+	b.Run("ExactCoversDLX", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			c.ExactCoversDLX()
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package cover
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteDOT writes a description of c's Subsets and Elements to w in the Graphviz DOT language.
+// Subsets are drawn as boxes on one side and Elements as ellipses on the other,
+// with an edge joining each Subset to each Element it contains.
+// Nodes are labeled with the fmt.Sprint representations of their Subsets and Elements
+// and sorted by them.
+// Subsets found to be essential by the most recent call to Minimize are filled.
+func (c *Cover) WriteDOT(w io.Writer) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var b strings.Builder
+	b.WriteString("graph cover {\n\trankdir=LR;\n")
+
+	ins := c.incidences()
+	b.WriteString("\tnode [shape=box, color=blue];\n")
+	for i, in := range ins {
+		fmt.Fprintf(&b, "\ts%d [label=%s", i, strconv.Quote(fmt.Sprint(in.Subset)))
+		if _, ok := c.essential[in.Subset]; ok {
+			b.WriteString(", style=filled, fillcolor=lightblue")
+		}
+		b.WriteString("];\n")
+	}
+
+	es := elements(c.in)
+	sortBySprint(es)
+	id := make(map[Element]int, len(es))
+	b.WriteString("\tnode [shape=ellipse, color=darkgreen];\n")
+	for i, e := range es {
+		id[e] = i
+		fmt.Fprintf(&b, "\te%d [label=%s];\n", i, strconv.Quote(fmt.Sprint(e)))
+	}
+
+	for i, in := range ins {
+		for _, e := range in.Elements {
+			fmt.Fprintf(&b, "\ts%d -- e%d;\n", i, id[e])
+		}
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
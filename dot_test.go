@@ -0,0 +1,36 @@
+package cover
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWriteDOT(t *testing.T) {
+	c := coverTests["B contains A"].c.Clone()
+	c.Add(`"C"`, "z")
+	c.Minimize()
+	var b strings.Builder
+	if err := c.WriteDOT(&b); err != nil {
+		t.Fatalf("WriteDOT: %v", err)
+	}
+	want := `graph cover {
+	rankdir=LR;
+	node [shape=box, color=blue];
+	s0 [label="\"C\""];
+	s1 [label="A"];
+	s2 [label="B", style=filled, fillcolor=lightblue];
+	node [shape=ellipse, color=darkgreen];
+	e0 [label="x"];
+	e1 [label="y"];
+	e2 [label="z"];
+	s0 -- e2;
+	s1 -- e0;
+	s2 -- e0;
+	s2 -- e1;
+	s2 -- e2;
+}
+`
+	if got := b.String(); got != want {
+		t.Errorf("WriteDOT: got\n%s\nwant\n%s", got, want)
+	}
+}
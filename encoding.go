@@ -0,0 +1,105 @@
+package cover
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dkmccandless/bipartite"
+)
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the Subsets added to c as a list of objects of the form
+// {"subset": s, "elements": [e1, e2]}, sorted by the fmt.Sprint representations of the Subsets and Elements.
+// Objects for Subsets added by AddWithCost also have a "cost" member.
+func (c *Cover) MarshalJSON() ([]byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return json.Marshal(c.incidences())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It replaces the contents of c with the Subsets and Elements encoded in data,
+// as if by New and Add, or AddWithCost for objects with a "cost" member.
+//
+// Subsets and Elements decode as the values produced by encoding/json for an interface{},
+// so only strings, booleans, null, and numbers round-trip, and all numbers decode as float64.
+// UnmarshalJSON returns an error if any Subset or Element is a JSON array or object,
+// which cannot be stored in a Cover because it decodes as a value that is not comparable.
+func (c *Cover) UnmarshalJSON(data []byte) error {
+	var ins []incidence
+	if err := json.Unmarshal(data, &ins); err != nil {
+		return err
+	}
+	return c.setIncidences(ins)
+}
+
+// setIncidences replaces the contents of c with ins, as if by New and addIncidence.
+// It returns an error without modifying c if any Subset or Element is not comparable.
+func (c *Cover) setIncidences(ins []incidence) error {
+	for _, in := range ins {
+		if err := checkComparable(in.Subset); err != nil {
+			return err
+		}
+		for _, e := range in.Elements {
+			if err := checkComparable(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.in = bipartite.New()
+	c.m = bipartite.New()
+	c.essential = make(sset)
+	c.cost = nil
+	c.meta = nil
+	c.dontCare = nil
+	clear(c.subsetRep)
+	clear(c.elementRep)
+	c.last = nil
+	for _, in := range ins {
+		c.addIncidence(in)
+	}
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+// It encodes the Subsets added to c, the Elements they contain, and any costs given by AddWithCost.
+// The results of any call to Minimize are not encoded.
+//
+// Subsets and Elements are encoded as interface values, so their concrete types
+// must be registered with gob.Register unless they are among the basic types gob registers itself,
+// such as strings, booleans, and numbers.
+func (c *Cover) GobEncode() ([]byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(c.incidences()); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+// It replaces the contents of c with the Subsets and Elements encoded in data,
+// as if by New and Add, or AddWithCost for Subsets with costs.
+// The concrete types of the Subsets and Elements must be registered as for GobEncode.
+// GobDecode returns an error if any of them is not comparable.
+func (c *Cover) GobDecode(data []byte) error {
+	var ins []incidence
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&ins); err != nil {
+		return err
+	}
+	return c.setIncidences(ins)
+}
+
+// checkComparable returns an error if v cannot be used as a Subset or Element.
+func checkComparable(v interface{}) error {
+	if !isComparable(v) {
+		return fmt.Errorf("cover: %T value %v is not comparable", v, v)
+	}
+	return nil
+}
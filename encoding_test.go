@@ -0,0 +1,131 @@
+package cover
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/dkmccandless/bipartite"
+)
+
+func TestJSON(t *testing.T) {
+	c := New()
+	c.Add("A", "x", 1.5, true)
+	c.Add(2.0, nil, "x")
+	b, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("Marshal(%v): %v", c, err)
+	}
+	if want := `[{"subset":2,"elements":[null,"x"]},{"subset":"A","elements":[1.5,true,"x"]}]`; string(b) != want {
+		t.Errorf("Marshal(%v): got %s, want %s", c, b, want)
+	}
+
+	got := New()
+	got.Add("stale", "data")
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("Unmarshal(%s): %v", b, err)
+	}
+	if !reflect.DeepEqual(got, c) {
+		t.Errorf("Unmarshal(%s): got %v, want %v", b, got, c)
+	}
+
+	for name, test := range coverTests {
+		b, err := json.Marshal(test.c)
+		if err != nil {
+			t.Fatalf("Marshal(%v): %v", name, err)
+		}
+		got := New()
+		if err := json.Unmarshal(b, got); err != nil {
+			t.Fatalf("Unmarshal(%v): %v", name, err)
+		}
+		// Numbers round-trip as float64, so compare the text representations.
+		if got.String() != test.c.String() {
+			t.Errorf("Unmarshal(%v): got %v, want %v", name, got, test.c)
+		}
+	}
+}
+
+func TestJSONCost(t *testing.T) {
+	c := New()
+	c.AddWithCost("A", 2.5, "x")
+	c.Add("B", "x", "y")
+	b, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("Marshal(%v): %v", c, err)
+	}
+	if want := `[{"subset":"A","elements":["x"],"cost":2.5},{"subset":"B","elements":["x","y"]}]`; string(b) != want {
+		t.Errorf("Marshal(%v): got %s, want %s", c, b, want)
+	}
+
+	got := New()
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("Unmarshal(%s): %v", b, err)
+	}
+	if !reflect.DeepEqual(got, c) {
+		t.Errorf("Unmarshal(%s): got %#v, want %#v", b, got, c)
+	}
+}
+
+func TestUnmarshalJSONError(t *testing.T) {
+	for _, s := range []string{
+		`{}`,
+		`[{"subset":[1],"elements":["x"]}]`,
+		`[{"subset":"A","elements":[{"x":1}]}]`,
+	} {
+		if err := json.Unmarshal([]byte(s), New()); err == nil {
+			t.Errorf("Unmarshal(%s): got nil error", s)
+		}
+	}
+}
+
+// point is a comparable Subset type for testing gob registration.
+type point struct{ X, Y int }
+
+func TestGob(t *testing.T) {
+	gob.Register(point{})
+	c := New()
+	c.Add("A", "x", 1, 1.5, true)
+	c.Add(point{1, 2}, nil, "x")
+	c.AddWithCost(2, 0.25, point{3, 4})
+	c.Minimize()
+
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(c); err != nil {
+		t.Fatalf("Encode(%v): %v", c, err)
+	}
+	got := New()
+	got.Add("stale", "data")
+	if err := gob.NewDecoder(&b).Decode(got); err != nil {
+		t.Fatalf("Decode(%v): %v", c, err)
+	}
+
+	// Decoding leaves the results of Minimize empty.
+	want := c.Clone()
+	want.m = bipartite.New()
+	want.essential = make(sset)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Decode: got %#v, want %#v", got, want)
+	}
+
+	for name, test := range coverTests {
+		b.Reset()
+		if err := gob.NewEncoder(&b).Encode(test.c); err != nil {
+			t.Fatalf("Encode(%v): %v", name, err)
+		}
+		got := New()
+		if err := gob.NewDecoder(&b).Decode(got); err != nil {
+			t.Fatalf("Decode(%v): %v", name, err)
+		}
+		if got.String() != test.c.String() {
+			t.Errorf("Decode(%v): got %v, want %v", name, got, test.c)
+		}
+	}
+}
+
+func TestGobDecodeError(t *testing.T) {
+	if err := New().GobDecode([]byte("not gob")); err == nil {
+		t.Errorf("GobDecode: got nil error")
+	}
+}
@@ -0,0 +1,93 @@
+package cover
+
+// ExactCovers returns all combinations of Subsets that partition the Elements;
+// that is, in which every Element is contained by exactly one Subset.
+// Unlike Minimize, which allows Elements to be covered more than once,
+// it returns exact covers of every length and does not simplify c.
+// If c contains no Elements, the only exact cover is the empty one.
+func (c *Cover) ExactCovers() [][]Subset {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	t := newTable(c.in, subsets(c.in))
+	x := &exact{
+		t:       t,
+		covered: newBitset(len(t.es)),
+	}
+	x.search()
+	return x.covers
+}
+
+// exact holds the state of a search for exact covers.
+type exact struct {
+	// t holds the Subsets to choose from and the Elements to cover.
+	t *table
+
+	// chosen holds the indices of the Subsets chosen along the current branch.
+	chosen []int
+
+	// covered holds the Elements contained by the chosen Subsets.
+	covered bitset
+
+	// covers holds the exact covers found so far.
+	covers [][]Subset
+}
+
+// search explores all extensions of x.chosen that might be exact covers.
+// It branches on the uncovered Element contained by the fewest Subsets that contain no covered Element,
+// one of which must be chosen.
+func (x *exact) search() {
+	e, n := -1, 0
+	for f, adj := range x.t.adj {
+		if x.covered.has(f) {
+			continue
+		}
+		var k int
+		for _, i := range adj {
+			if !x.covered.intersects(x.t.cov[i]) {
+				k++
+			}
+		}
+		if e == -1 || k < n {
+			e, n = f, k
+		}
+	}
+
+	if e == -1 {
+		// Every Element is covered exactly once.
+		cs := make([]Subset, 0, len(x.chosen))
+		for _, i := range x.chosen {
+			cs = append(cs, x.t.ss[i])
+		}
+		x.covers = append(x.covers, cs)
+		return
+	}
+
+	for _, i := range x.t.adj[e] {
+		if x.covered.intersects(x.t.cov[i]) {
+			continue
+		}
+		x.chosen = append(x.chosen, i)
+		x.covered.or(x.t.cov[i])
+		x.search()
+		x.covered.andNot(x.t.cov[i])
+		x.chosen = x.chosen[:len(x.chosen)-1]
+	}
+}
+
+// DisjointCovers returns the minimum-length combinations of Subsets that cover every Element
+// and of which no two contain the same Element. A combination of pairwise disjoint Subsets covers
+// every Element exactly once, so these are the shortest of the exact covers that ExactCovers returns.
+// Unlike Minimize, it does not allow any Element to be covered more than once,
+// so it returns nil if there is no exact cover, and its covering sets may be longer than those of Minimize.
+func (c *Cover) DisjointCovers() [][]Subset {
+	var covers [][]Subset
+	for _, cs := range c.ExactCovers() {
+		switch {
+		case covers == nil || len(cs) < len(covers[0]):
+			covers = [][]Subset{cs}
+		case len(cs) == len(covers[0]):
+			covers = append(covers, cs)
+		}
+	}
+	return covers
+}
@@ -0,0 +1,127 @@
+package cover
+
+import (
+	"fmt"
+	"testing"
+)
+
+// tilings returns a Cover whose Subsets are the placements of the polyominoes in shapes on a w×h board
+// and whose Elements are the board's cells.
+// Each shape is given as a list of cell offsets; its rotations and reflections are not generated.
+func tilings(w, h int, shapes ...[][2]int) *Cover {
+	c := New()
+	for n, shape := range shapes {
+		for x := 0; x < w; x++ {
+			for y := 0; y < h; y++ {
+				var cells []Element
+				for _, d := range shape {
+					cx, cy := x+d[0], y+d[1]
+					if cx >= w || cy >= h {
+						cells = nil
+						break
+					}
+					cells = append(cells, [2]int{cx, cy})
+				}
+				if cells != nil {
+					c.Add(fmt.Sprintf("%d@%d,%d", n, x, y), cells...)
+				}
+			}
+		}
+	}
+	return c
+}
+
+var (
+	horizontalDomino = [][2]int{{0, 0}, {1, 0}}
+	verticalDomino   = [][2]int{{0, 0}, {0, 1}}
+)
+
+func TestExactCovers(t *testing.T) {
+	// Knuth's example from "Dancing Links"
+	knuth := New()
+	knuth.Add(1, "C", "E", "F")
+	knuth.Add(2, "A", "D", "G")
+	knuth.Add(3, "B", "C", "F")
+	knuth.Add(4, "A", "D")
+	knuth.Add(5, "B", "G")
+	knuth.Add(6, "D", "E", "G")
+
+	for name, test := range map[string]struct {
+		c    *Cover
+		want [][]Subset
+	}{
+		"empty set": {New(), [][]Subset{{}}},
+		"Knuth":     {knuth, [][]Subset{{1, 4, 5}}},
+		"B contains A": {
+			coverTests["B contains A"].c,
+			[][]Subset{{"B"}},
+		},
+		"2 Subsets contain 1 Element": {
+			coverTests["2 Subsets contain 1 Element"].c,
+			[][]Subset{{"A"}, {"B"}},
+		},
+		"no exact cover": {
+			coverTests["seven-segment B"].c,
+			nil,
+		},
+		"2×3 dominoes": {
+			tilings(2, 3, horizontalDomino, verticalDomino),
+			[][]Subset{
+				{"0@0,0", "0@0,1", "0@0,2"},
+				{"0@0,0", "1@0,1", "1@1,1"},
+				{"1@0,0", "1@1,0", "0@0,2"},
+			},
+		},
+	} {
+		if got := test.c.ExactCovers(); len(got) != len(test.want) || !allMatch(got, test.want) {
+			t.Errorf("ExactCovers(%v): got %v, want %v", name, got, test.want)
+		}
+	}
+
+	// The number of domino tilings of a 2×n board is the (n+1)th Fibonacci number.
+	if got := len(tilings(2, 8, horizontalDomino, verticalDomino).ExactCovers()); got != 34 {
+		t.Errorf("ExactCovers(2×8 dominoes): got %d covers, want 34", got)
+	}
+}
+
+func TestDisjointCovers(t *testing.T) {
+	// A and B overlap, so DisjointCovers returns only the other minimum covering sets, which do not.
+	c := New()
+	c.Add("A", 1, 2, 3)
+	c.Add("B", 3, 4, 5)
+	c.Add("C", 1, 2)
+	c.Add("D", 4, 5)
+	c.Add("E", 1)
+	c.Add("F", 2, 3)
+	if got, want := c.Clone().AllCovers(2), [][]Subset{{"A", "B"}, {"A", "D"}, {"B", "C"}}; len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("AllCovers(%v, 2): got %v, want %v", c, got, want)
+	}
+	want := [][]Subset{{"A", "D"}, {"C", "B"}}
+	if got := c.DisjointCovers(); len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("DisjointCovers(%v): got %v, want %v", c, got, want)
+	}
+
+	// The only disjoint covering set is longer than the minimum.
+	c = New()
+	c.Add("A", 1, 2, 3)
+	c.Add("B", 3, 4, 5)
+	c.Add("D", 4)
+	c.Add("E", 5)
+	want = [][]Subset{{"A", "D", "E"}}
+	if got := c.DisjointCovers(); len(got) != len(want) || !allMatch(got, want) {
+		t.Errorf("DisjointCovers(%v): got %v, want %v", c, got, want)
+	}
+
+	// Every pair of Subsets overlaps.
+	c = New()
+	c.Add("A", 1, 2)
+	c.Add("B", 2, 3)
+	c.Add("C", 3, 1)
+	if got := c.DisjointCovers(); got != nil {
+		t.Errorf("DisjointCovers(%v): got %v, want nil", c, got)
+	}
+
+	if got := New().DisjointCovers(); len(got) != 1 || len(got[0]) != 0 {
+		t.Errorf("DisjointCovers(empty): got %v, want [[]]", got)
+	}
+}
@@ -0,0 +1,32 @@
+package cover
+
+import "math/rand"
+
+// Generate returns a random Cover of numSubsets Subsets and numElements Elements, labeled by the ints
+// from 0 to numSubsets-1 and from 0 to numElements-1, in which each Subset contains each Element
+// with probability density. Each Element that no Subset contains is then added to a Subset chosen at random,
+// so that every Element is contained by at least one Subset and the Cover has a covering set.
+// A Subset that contains no Elements is not added, so the Cover may have fewer than numSubsets Subsets.
+// If numSubsets is not positive, the Cover is empty.
+//
+// The result depends only on the arguments: calls with the same arguments return Covers that are Equal.
+func Generate(seed int64, numSubsets, numElements int, density float64) *Cover {
+	c := New()
+	if numSubsets <= 0 {
+		return c
+	}
+	r := rand.New(rand.NewSource(seed))
+	for e := 0; e < numElements; e++ {
+		var covered bool
+		for s := 0; s < numSubsets; s++ {
+			if r.Float64() < density {
+				c.add(s, e)
+				covered = true
+			}
+		}
+		if !covered {
+			c.add(r.Intn(numSubsets), e)
+		}
+	}
+	return c
+}
@@ -0,0 +1,48 @@
+package cover
+
+import "testing"
+
+func TestGenerate(t *testing.T) {
+	for _, test := range []struct {
+		seed                      int64
+		numSubsets, numElements   int
+		density                   float64
+		wantSubsets, wantElements int
+	}{
+		{1, 10, 20, 0.3, 10, 20},
+		{2, 5, 50, 0, 0, 50},
+		{3, 8, 8, 1, 8, 8},
+		{4, 0, 10, 0.5, 0, 0},
+		{5, 10, 0, 0.5, 0, 0},
+	} {
+		c := Generate(test.seed, test.numSubsets, test.numElements, test.density)
+		if test.density == 0 {
+			// Every Element is added to a single Subset, so the number of Subsets varies.
+			test.wantSubsets = c.NumSubsets()
+		}
+		if c.NumSubsets() != test.wantSubsets || c.NumElements() != test.wantElements {
+			t.Errorf("Generate(%d, %d, %d, %v): got %d Subsets and %d Elements, want %d and %d",
+				test.seed, test.numSubsets, test.numElements, test.density,
+				c.NumSubsets(), c.NumElements(), test.wantSubsets, test.wantElements)
+		}
+		if !c.IsCover(subsets(c.in)) {
+			t.Errorf("Generate(%d, %d, %d, %v): Subsets do not cover every Element", test.seed, test.numSubsets, test.numElements, test.density)
+		}
+		if d := Generate(test.seed, test.numSubsets, test.numElements, test.density); !d.Equal(c) {
+			t.Errorf("Generate(%d, %d, %d, %v): results differ", test.seed, test.numSubsets, test.numElements, test.density)
+		}
+	}
+	if Generate(1, 10, 20, 0.3).Equal(Generate(2, 10, 20, 0.3)) {
+		t.Errorf("Generate: seeds 1 and 2 give Equal Covers")
+	}
+
+	// The result does not change across runs or platforms.
+	want := New()
+	want.Add(0, 2, 3)
+	want.Add(1, 0, 1, 5)
+	want.Add(2, 0, 1, 3)
+	want.Add(3, 1, 2, 3, 4, 5)
+	if got := Generate(7, 4, 6, 0.4); !got.Equal(want) {
+		t.Errorf("Generate(7, 4, 6, 0.4): got %v, want %v", got, want)
+	}
+}
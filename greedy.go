@@ -0,0 +1,226 @@
+package cover
+
+import (
+	"math/rand"
+	"slices"
+)
+
+// Greedy returns a covering set found by the greedy heuristic, which repeatedly chooses the Subset
+// that contains the most Elements not yet covered, breaking ties in favor of the Subset
+// that sorts first by its fmt.Sprint representation. The Subsets are returned in the order chosen.
+//
+// Greedy takes polynomial time, but its covering set is not necessarily minimum:
+// its length is at most H(d) times the minimum, where d is the greatest number of Elements
+// contained by any Subset and H(d) = 1 + 1/2 + ... + 1/d ≤ 1 + ln d.
+func (c *Cover) Greedy() []Subset {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	ss := subsets(c.in)
+	sortBySprint(ss)
+	t := newTable(c.in, ss)
+	var cs []Subset
+	for _, i := range greedy(t) {
+		cs = append(cs, ss[i])
+	}
+	return cs
+}
+
+// greedy returns the indices of the Subsets of t chosen by the greedy heuristic, in the order chosen.
+// Ties are broken in favor of the lowest index.
+func greedy(t *table) []int {
+	covered := newBitset(len(t.es))
+	u := newBitset(len(t.es))
+	var chosen []int
+	for !covered.equal(t.all) {
+		best, n := -1, 0
+		for i := range t.ss {
+			u.clear()
+			u.or(t.cov[i])
+			u.andNot(covered)
+			if k := u.count(); k > n {
+				best, n = i, k
+			}
+		}
+		covered.or(t.cov[best])
+		chosen = append(chosen, best)
+	}
+	return chosen
+}
+
+// GreedyPriority returns a covering set found by the greedy heuristic as described for Greedy,
+// except that among the Subsets that contain the most Elements not yet covered, it chooses the one
+// for which the sum of priority over those Elements is greatest, and breaks any remaining ties
+// in favor of the Subset that sorts first by its fmt.Sprint representation.
+// The Subsets are returned in the order chosen. If priority is constant, GreedyPriority returns the same result as Greedy.
+// priority is called without c locked, so it may call methods of c such as Frequency.
+func (c *Cover) GreedyPriority(priority func(Element) int) []Subset {
+	c.mu.RLock()
+	ss := subsets(c.in)
+	sortBySprint(ss)
+	t := newTable(c.in, ss)
+	c.mu.RUnlock()
+
+	prio := make([]int, len(t.es))
+	for j, e := range t.es {
+		prio[j] = priority(e)
+	}
+	covered := newBitset(len(t.es))
+	u := newBitset(len(t.es))
+	var cs []Subset
+	for !covered.equal(t.all) {
+		best, n, p := -1, 0, 0
+		for i := range t.ss {
+			u.clear()
+			u.or(t.cov[i])
+			u.andNot(covered)
+			k := u.count()
+			if k == 0 || k < n {
+				continue
+			}
+			var sum int
+			for j := u.next(0); j >= 0; j = u.next(j + 1) {
+				sum += prio[j]
+			}
+			if k > n || sum > p {
+				best, n, p = i, k, sum
+			}
+		}
+		covered.or(t.cov[best])
+		cs = append(cs, ss[best])
+	}
+	return cs
+}
+
+// GreedyCost returns a covering set found by the weighted greedy heuristic, and its total cost.
+// It repeatedly chooses the Subset with the least ratio of its cost, as given by AddWithCost,
+// to the number of Elements it contains that are not yet covered, breaking ties in favor of
+// the Subset that sorts first by its fmt.Sprint representation. The Subsets are returned in the order chosen.
+//
+// Like Greedy, GreedyCost takes polynomial time, and the cost of its covering set is at most H(d) times
+// the least cost of any covering set, where d is the greatest number of Elements contained by any Subset.
+func (c *Cover) GreedyCost() ([]Subset, float64) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	ss := subsets(c.in)
+	sortBySprint(ss)
+	t := newTable(c.in, ss)
+	covered := newBitset(len(t.es))
+	u := newBitset(len(t.es))
+	var cs []Subset
+	var total float64
+	for !covered.equal(t.all) {
+		best, ratio := -1, 0.0
+		for i, s := range ss {
+			u.clear()
+			u.or(t.cov[i])
+			u.andNot(covered)
+			k := u.count()
+			if k == 0 {
+				continue
+			}
+			if r := c.costOf(s) / float64(k); best == -1 || r < ratio {
+				best, ratio = i, r
+			}
+		}
+		covered.or(t.cov[best])
+		cs = append(cs, ss[best])
+		total += c.costOf(ss[best])
+	}
+	return cs, total
+}
+
+// MinimizeBB returns the same result as Minimize. After simplification, it searches the cyclic core
+// by branch and bound, using the length of a covering set found by Greedy as the initial bound
+// so that every branch that cannot produce a covering set at least as short is pruned from the start.
+// When Greedy finds a minimum covering set, this prunes most of the search.
+func (c *Cover) MinimizeBB() [][]Subset {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.prepare()
+	ess, isUnique := c.reduce()
+	if isUnique {
+		return [][]Subset{ess}
+	}
+	b := newBnb(newTable(c.m, subsets(c.m)), ess)
+	b.best = len(greedy(b.t))
+	b.search()
+	return b.covers
+}
+
+// MinimizeRandom returns a covering set found by randomized local search, which may be shorter
+// than the one Greedy finds when the cyclic core is too large for Minimize to search exhaustively.
+// After simplification, it starts from the covering set of the cyclic core found by the greedy heuristic
+// and, for the given number of iterations, replaces a randomly chosen Subset of the current covering set
+// with a randomly chosen Subset outside it, keeping the change if the result is still a covering set
+// once any Subsets it no longer needs are removed. It returns the shortest covering set found,
+// including the essential Subsets, sorted by the fmt.Sprint representations of its Subsets.
+//
+// MinimizeRandom is a heuristic, and its covering set is not necessarily minimum.
+// Its result depends only on c, seed, and iterations. It does not modify c.
+func (c *Cover) MinimizeRandom(seed int64, iterations int) []Subset {
+	c.mu.RLock()
+	s, isUnique := c.simplified()
+	c.mu.RUnlock()
+	cs := s.Essential()
+	if isUnique {
+		return cs
+	}
+
+	ss := subsets(s.m)
+	sortBySprint(ss)
+	t := newTable(s.m, ss)
+	r := rand.New(rand.NewSource(seed))
+	cur := greedy(t)
+	cur = prune(t, cur, r)
+	best := slices.Clone(cur)
+	u := newBitset(len(t.es))
+	for n := 0; n < iterations && len(cur) < len(t.ss); n++ {
+		i := r.Intn(len(cur))
+		j := r.Intn(len(t.ss))
+		if slices.Contains(cur, j) {
+			continue
+		}
+		u.clear()
+		for k, x := range cur {
+			if k != i {
+				u.or(t.cov[x])
+			}
+		}
+		u.or(t.cov[j])
+		if !u.equal(t.all) {
+			continue
+		}
+		cur[i] = j
+		cur = prune(t, cur, r)
+		if len(cur) < len(best) {
+			best = append(best[:0], cur...)
+		}
+	}
+
+	for _, i := range best {
+		cs = append(cs, t.ss[i])
+	}
+	sortBySprint(cs)
+	return cs
+}
+
+// prune removes from the covering set idx of the Subsets of t any Subsets whose Elements
+// are all contained by the others, considering them in random order, and returns the result.
+func prune(t *table, idx []int, r *rand.Rand) []int {
+	r.Shuffle(len(idx), func(i, j int) { idx[i], idx[j] = idx[j], idx[i] })
+	u := newBitset(len(t.es))
+	for k := 0; k < len(idx); {
+		u.clear()
+		for l, x := range idx {
+			if l != k {
+				u.or(t.cov[x])
+			}
+		}
+		if u.equal(t.all) {
+			idx = slices.Delete(idx, k, k+1)
+			continue
+		}
+		k++
+	}
+	return idx
+}
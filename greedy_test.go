@@ -0,0 +1,196 @@
+package cover
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestGreedy(t *testing.T) {
+	for name, test := range coverTests {
+		got := test.c.Greedy()
+		if !test.c.IsCover(got) {
+			t.Errorf("Greedy(%v): got %v, not a covering set", name, got)
+		}
+		if n := len(test.min[0]); len(got) < n {
+			t.Errorf("Greedy(%v): got %v, shorter than minimum %d", name, got, n)
+		}
+	}
+
+	// Greedy chooses B first and then needs both A and C, but A and C suffice.
+	c := New()
+	c.Add("A", 1, 2, 3, 4)
+	c.Add("B", 3, 4, 5, 6, 7)
+	c.Add("C", 5, 6, 7, 8)
+	c.Add("D", 1, 2, 3)
+	if got, want := c.Greedy(), []Subset{"B", "A", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Greedy(%v): got %v, want %v", c, got, want)
+	}
+	if got := New().Greedy(); got != nil {
+		t.Errorf("Greedy(empty): got %v, want nil", got)
+	}
+}
+
+func TestGreedyPriority(t *testing.T) {
+	// A and B each contain three Elements, so Greedy chooses A first,
+	// but B contains the Element of highest priority.
+	c := New()
+	c.Add("A", 1, 2, 3)
+	c.Add("B", 3, 4, 5)
+	c.Add("C", 1, 2)
+	c.Add("D", 4, 5)
+	if got, want := c.Greedy(), []Subset{"A", "B"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Greedy(%v): got %v, want %v", c, got, want)
+	}
+	priority := func(e Element) int {
+		if e == 5 {
+			return 10
+		}
+		return 1
+	}
+	if got, want := c.GreedyPriority(priority), []Subset{"B", "A"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GreedyPriority(%v): got %v, want %v", c, got, want)
+	}
+
+	// With a constant priority, GreedyPriority chooses the same Subsets as Greedy.
+	constant := func(Element) int { return 1 }
+	for name, test := range coverTests {
+		if got, want := test.c.GreedyPriority(constant), test.c.Greedy(); !reflect.DeepEqual(got, want) {
+			t.Errorf("GreedyPriority(%v): got %v, want %v", name, got, want)
+		}
+	}
+	// priority may call methods of c.
+	frequency := func(e Element) int { return c.Frequency(e) }
+	if got, want := c.GreedyPriority(frequency), c.Greedy(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GreedyPriority(%v) by Frequency: got %v, want %v", c, got, want)
+	}
+	if got := New().GreedyPriority(constant); got != nil {
+		t.Errorf("GreedyPriority(empty): got %v, want nil", got)
+	}
+}
+
+func TestGreedyCost(t *testing.T) {
+	// Greedy chooses A, which contains the most Elements, but B, C, and D cost less together.
+	c := New()
+	c.AddWithCost("A", 10, 1, 2, 3)
+	c.AddWithCost("B", 1, 1)
+	c.AddWithCost("C", 1.5, 2, 4)
+	c.Add("D", 3)
+	if got, want := c.Greedy(), []Subset{"A", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Greedy(%v): got %v, want %v", c, got, want)
+	}
+	got, cost := c.GreedyCost()
+	if want := []Subset{"C", "B", "D"}; !reflect.DeepEqual(got, want) || cost != 3.5 {
+		t.Errorf("GreedyCost(%v): got %v, %v; want %v, 3.5", c, got, cost, want)
+	}
+
+	// With unit costs, GreedyCost chooses the same Subsets as Greedy.
+	for name, test := range coverTests {
+		want := test.c.Greedy()
+		if got, cost := test.c.GreedyCost(); !reflect.DeepEqual(got, want) || cost != float64(len(want)) {
+			t.Errorf("GreedyCost(%v): got %v, %v; want %v, %d", name, got, cost, want, len(want))
+		}
+	}
+	if got, cost := New().GreedyCost(); got != nil || cost != 0 {
+		t.Errorf("GreedyCost(empty): got %v, %v; want nil, 0", got, cost)
+	}
+}
+
+func TestGap(t *testing.T) {
+	for name, test := range coverTests {
+		want := 1.0
+		if lb := test.c.LowerBound(); lb > 0 {
+			want = float64(len(test.min[0])) / lb
+		}
+		for _, cs := range test.min {
+			if got := test.c.Gap(cs); got != want || got < 1 {
+				t.Errorf("Gap(%v, %v): got %v, want %v", name, cs, got, want)
+			}
+		}
+	}
+
+	c := New()
+	c.Add("A", 1, 2, 3, 4)
+	c.Add("B", 3, 4, 5, 6, 7)
+	c.Add("C", 5, 6, 7, 8)
+	c.Add("D", 1, 2, 3)
+	if got, want := c.Gap(c.Greedy()), 1.5; got != want {
+		t.Errorf("Gap(%v): got %v, want %v", c.Greedy(), got, want)
+	}
+	if got := c.Gap([]Subset{"A", "B"}); !math.IsNaN(got) {
+		t.Errorf("Gap(not a covering set): got %v, want NaN", got)
+	}
+	if got := New().Gap(nil); got != 1 {
+		t.Errorf("Gap(empty): got %v, want 1", got)
+	}
+
+	// With costs, the lower bound prices each Element at the least cost per Element of a Subset containing it:
+	// 1 and 2 at 2/2 from A, 3 at 6/2 from B, and 4 at 3/1 from C.
+	c = New()
+	c.AddWithCost("A", 2, 1, 2)
+	c.AddWithCost("B", 6, 2, 3)
+	c.AddWithCost("C", 3, 4)
+	c.AddWithCost("D", 8, 3, 4)
+	if got, want := c.Gap([]Subset{"A", "B", "C"}), 11.0/8; got != want {
+		t.Errorf("Gap(A, B, C): got %v, want %v", got, want)
+	}
+	if got, want := c.Gap([]Subset{"A", "D"}), 10.0/8; got != want {
+		t.Errorf("Gap(A, D): got %v, want %v", got, want)
+	}
+
+	// After Reset, c has no costs, as if returned by New.
+	c.Reset()
+	c.Add("S1", "a", "b")
+	c.Add("S2", "b", "c")
+	c.Add("S3", "c", "a")
+	if got, want := c.Gap([]Subset{"S1", "S2"}), 1.0; got != want {
+		t.Errorf("Gap(S1, S2) after Reset: got %v, want %v", got, want)
+	}
+}
+
+func TestMinimizeBB(t *testing.T) {
+	for name, test := range coverTests {
+		if got := test.c.Clone().MinimizeBB(); len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("MinimizeBB(%v): got %v, want %v", name, got, test.min)
+		}
+	}
+	for seed := int64(0); seed < 10; seed++ {
+		c := Generate(seed, 30, 20, 0.2)
+		want := c.Clone().Minimize()
+		if got := c.MinimizeBB(); len(got) != len(want) || !allMatch(got, want) {
+			t.Errorf("MinimizeBB(random %d): got %v, want %v", seed, got, want)
+		}
+	}
+}
+
+func TestMinimizeRandom(t *testing.T) {
+	for name, test := range coverTests {
+		want := test.c.Clone()
+		got := test.c.MinimizeRandom(1, 100)
+		if !test.c.IsCover(got) {
+			t.Errorf("MinimizeRandom(%v): got %v, not a covering set", name, got)
+		}
+		if n := len(test.min[0]); len(got) < n {
+			t.Errorf("MinimizeRandom(%v): got %v, shorter than minimum %d", name, got, n)
+		}
+		if !reflect.DeepEqual(test.c, want) {
+			t.Errorf("MinimizeRandom(%v): modified receiver", name)
+		}
+	}
+	for seed := int64(0); seed < 10; seed++ {
+		c := Generate(seed, 30, 20, 0.2)
+		got := c.MinimizeRandom(seed, 1000)
+		if !c.IsCover(got) {
+			t.Errorf("MinimizeRandom(random %d): got %v, not a covering set", seed, got)
+		}
+		if n := c.MinSize(); len(got) != n {
+			t.Errorf("MinimizeRandom(random %d): got %v, want length %d", seed, got, n)
+		}
+		if again := c.MinimizeRandom(seed, 1000); !reflect.DeepEqual(again, got) {
+			t.Errorf("MinimizeRandom(random %d): got %v, then %v", seed, got, again)
+		}
+	}
+	if got := New().MinimizeRandom(0, 10); got != nil {
+		t.Errorf("MinimizeRandom(empty): got %v, want nil", got)
+	}
+}
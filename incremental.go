@@ -0,0 +1,123 @@
+package cover
+
+import "github.com/dkmccandless/bipartite"
+
+// snapshot records the state of a Cover at the end of a call to MinimizeIncremental.
+// Its fields are not modified once it has been recorded.
+type snapshot struct {
+	// in, m, and essential hold copies of the fields of the Cover.
+	in, m     *bipartite.Graph
+	essential sset
+
+	// covers holds the covering sets returned.
+	covers [][]Subset
+}
+
+// MinimizeIncremental returns the same result as Minimize, reusing the result of the previous call
+// to MinimizeIncremental where possible so that only the Subsets and Elements added since need be examined.
+//
+// The previous result remains valid, and is reused, if every Subset and Element recorded then
+// still contains or is contained by the same Subsets and Elements, and every Subset that has been added since
+// contains only Elements that have also been added since. In that case the new Subsets and Elements
+// share nothing with the old ones, so no reduction that applied to one can affect the other:
+// the essential Subsets and cyclic core of the old ones are unchanged, MinimizeIncremental minimizes
+// only the new ones, and each of its covering sets is the union of an old one and a new one.
+// Otherwise, as when Elements are added to a Subset already recorded, a new Subset contains an Element
+// already recorded, or the Cover has been Reset or decoded since, it falls back to a full call to Minimize.
+// The first call always does so.
+//
+// MinimizeIncremental keeps a copy of c for comparison with the next call. Clone does not copy it.
+func (c *Cover) MinimizeIncremental() [][]Subset {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var covers [][]Subset
+	if add, ok := c.added(); ok {
+		covers = c.solveAdded(add)
+	} else {
+		covers = c.minimize()
+	}
+	c.last = &snapshot{
+		in: bipartite.Copy(c.in),
+		m:  bipartite.Copy(c.m),
+
+		essential: c.essential.copy(),
+
+		covers: copyCovers(covers),
+	}
+	return covers
+}
+
+// added returns a new Cover of the Subsets added to c since its last solution,
+// and reports whether that solution remains valid as described for MinimizeIncremental.
+func (c *Cover) added() (*Cover, bool) {
+	if c.last == nil {
+		return nil, false
+	}
+	old := c.last.in
+	for _, s := range old.As() {
+		if c.in.DegA(s) != old.DegA(s) {
+			return nil, false
+		}
+		for _, e := range old.AdjToA(s) {
+			if !c.in.Adjacent(s, e) {
+				return nil, false
+			}
+		}
+	}
+	// New Subsets are compared using the don't-care Elements of c.
+	add := New()
+	add.dontCare = c.dontCare
+	for _, s := range c.in.As() {
+		if old.DegA(s) > 0 {
+			continue
+		}
+		for _, e := range c.in.AdjToA(s) {
+			if old.DegB(e) > 0 {
+				return nil, false
+			}
+			add.in.Add(s, e)
+		}
+	}
+	return add, true
+}
+
+// solveAdded returns the covering sets of c given its last solution and the Cover add of the Subsets added since,
+// and sets c.m and c.essential as Minimize would.
+func (c *Cover) solveAdded(add *Cover) [][]Subset {
+	c.m = bipartite.Copy(c.last.m)
+	c.essential = c.last.essential.copy()
+	if add.in.NA() == 0 {
+		return copyCovers(c.last.covers)
+	}
+
+	newCovers := add.minimize()
+	for _, s := range add.m.As() {
+		for _, e := range add.m.AdjToA(s) {
+			c.m.Add(s, e)
+		}
+	}
+	for s := range add.essential {
+		c.essential[s] = struct{}{}
+	}
+	if c.last.in.NA() == 0 {
+		return newCovers
+	}
+
+	covers := make([][]Subset, 0, len(c.last.covers)*len(newCovers))
+	for _, p := range c.last.covers {
+		for _, q := range newCovers {
+			cs := append(make([]Subset, 0, len(p)+len(q)), p...)
+			covers = append(covers, append(cs, q...))
+		}
+	}
+	return covers
+}
+
+// copyCovers returns a copy of covers that shares no memory with it.
+func copyCovers(covers [][]Subset) [][]Subset {
+	cp := make([][]Subset, len(covers))
+	for i, cs := range covers {
+		cp[i] = append([]Subset(nil), cs...)
+	}
+	return cp
+}
@@ -0,0 +1,79 @@
+package cover
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMinimizeIncremental(t *testing.T) {
+	// check compares the result of MinimizeIncremental with that of Minimize and reports whether it reused the last solution.
+	check := func(name string, c *Cover) bool {
+		t.Helper()
+		full := c.Clone()
+		want := full.Minimize()
+		_, reused := c.added()
+		got := c.MinimizeIncremental()
+		if len(got) != len(want) || !allMatch(got, want) {
+			t.Errorf("MinimizeIncremental(%v): got %v, want %v", name, got, want)
+		}
+		if gotEss, wantEss := c.Essential(), full.Essential(); !reflect.DeepEqual(gotEss, wantEss) {
+			t.Errorf("MinimizeIncremental(%v): got essential Subsets %v, want %v", name, gotEss, wantEss)
+		}
+		if !reflect.DeepEqual(c.m, full.m) {
+			t.Errorf("MinimizeIncremental(%v): got cyclic core %v, want %v", name, c.m, full.m)
+		}
+		return reused
+	}
+
+	c := New()
+	if check("empty", c) {
+		t.Errorf("MinimizeIncremental(empty): reused the last solution on the first call")
+	}
+	for _, test := range []struct {
+		name   string
+		add    func(c *Cover)
+		reused bool
+	}{
+		{"nothing added", func(c *Cover) {}, true},
+		{"cycle", func(c *Cover) {
+			c.Add("A", 1, 2)
+			c.Add("B", 2, 3)
+			c.Add("C", 3, 1)
+		}, true},
+		{"disjoint essential", func(c *Cover) { c.Add("D", 4, 5) }, true},
+		{"disjoint cycle", func(c *Cover) {
+			c.Add("E", 6, 7)
+			c.Add("F", 7, 8)
+			c.Add("G", 8, 6)
+		}, true},
+		{"nothing added again", func(c *Cover) {}, true},
+		{"new Element of old Subset", func(c *Cover) { c.Add("A", 9) }, false},
+		{"new Subset of old Element", func(c *Cover) { c.Add("H", 3, 4, 10) }, false},
+		{"dominating Subset", func(c *Cover) { c.Add("I", 11, 12) }, true},
+		{"duplicate Subset", func(c *Cover) { c.Add("J", 11, 12) }, false},
+		{"Reset", func(c *Cover) {
+			c.Reset()
+			c.Add("A", 1)
+		}, false},
+	} {
+		test.add(c)
+		if reused := check(test.name, c); reused != test.reused {
+			t.Errorf("MinimizeIncremental(%v): got reused %v, want %v", test.name, reused, test.reused)
+		}
+	}
+
+	for seed := int64(0); seed < 10; seed++ {
+		c := Generate(seed, 10, 10, 0.3)
+		c.MinimizeIncremental()
+		for s := 10; s < 20; s++ {
+			for e := 10; e < 20; e++ {
+				if (s+e+int(seed))%3 == 0 {
+					c.Add(s, e)
+				}
+			}
+		}
+		if !check("random", c) {
+			t.Errorf("MinimizeIncremental(random %d): did not reuse the last solution", seed)
+		}
+	}
+}
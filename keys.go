@@ -0,0 +1,85 @@
+package cover
+
+import "github.com/dkmccandless/bipartite"
+
+// NewWithKey returns an empty Cover that identifies Elements by their keys as returned by key,
+// rather than by the Elements themselves. It is equivalent to NewWithKeys(nil, key).
+func NewWithKey(key func(Element) interface{}) *Cover {
+	return NewWithKeys(nil, key)
+}
+
+// NewWithKeys returns an empty Cover that identifies Subsets and Elements by their keys
+// as returned by subsetKey and elementKey, rather than by the Subsets and Elements themselves.
+// A nil key function identifies Subsets or Elements by their own values, as with New.
+// Keys must be comparable.
+//
+// Subsets or Elements with equal keys are treated as the same Subset or Element,
+// which is represented by the first of them to be added to c.
+// Methods that return Subsets or Elements return their representatives.
+// Methods that take Subsets or Elements as arguments, such as Size, Frequency, Cost, and IsCover,
+// identify them by their keys, so that, for example, Frequency(e) is the number of Subsets
+// that contain an Element with the same key as e.
+// Clone, Reset, and decoding preserve the key functions; Merge and Transpose do not use other's.
+func NewWithKeys(subsetKey func(Subset) interface{}, elementKey func(Element) interface{}) *Cover {
+	c := &Cover{
+		in: bipartite.New(),
+		m:  bipartite.New(),
+
+		essential: make(sset),
+
+		subsetKey:  subsetKey,
+		elementKey: elementKey,
+	}
+	if subsetKey != nil {
+		c.subsetRep = make(map[interface{}]Subset)
+	}
+	if elementKey != nil {
+		c.elementRep = make(map[interface{}]Element)
+	}
+	return c
+}
+
+// subset returns the representative of the Subsets with the same key as s:
+// the first one to be added to c, or s if none has been.
+// If add is true and none has been, s becomes the representative.
+func (c *Cover) subset(s Subset, add bool) Subset {
+	if c.subsetKey == nil {
+		return s
+	}
+	k := c.subsetKey(s)
+	if r, ok := c.subsetRep[k]; ok {
+		return r
+	}
+	if add {
+		c.subsetRep[k] = s
+	}
+	return s
+}
+
+// element returns the representative of the Elements with the same key as e:
+// the first one to be added to c, or e if none has been.
+// If add is true and none has been, e becomes the representative.
+func (c *Cover) element(e Element, add bool) Element {
+	if c.elementKey == nil {
+		return e
+	}
+	k := c.elementKey(e)
+	if r, ok := c.elementRep[k]; ok {
+		return r
+	}
+	if add {
+		c.elementRep[k] = e
+	}
+	return e
+}
+
+// forgetSubset deletes s as the representative of its key if it no longer contains any Elements,
+// so that the next Subset added with that key represents it.
+func (c *Cover) forgetSubset(s Subset) {
+	if c.subsetKey == nil || c.in.DegA(s) > 0 {
+		return
+	}
+	if k := c.subsetKey(s); c.subsetRep[k] == s {
+		delete(c.subsetRep, k)
+	}
+}
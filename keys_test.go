@@ -0,0 +1,89 @@
+package cover
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// server is an Element identified by its name.
+type server struct {
+	name   string
+	region string
+}
+
+func TestNewWithKey(t *testing.T) {
+	c := NewWithKey(func(e Element) interface{} { return e.(server).name })
+	a1, a2 := server{"a", "us"}, server{"a", "eu"}
+	b := server{"b", "us"}
+	c.Add("P", a1, b)
+	c.Add("Q", a2)
+	c.AddSet("R", map[Element]struct{}{a2: {}})
+
+	if got := c.NumElements(); got != 2 {
+		t.Errorf("NumElements: got %d, want 2", got)
+	}
+	for _, e := range []Element{a1, a2, server{"a", ""}} {
+		if got := c.Frequency(e); got != 3 {
+			t.Errorf("Frequency(%v): got %d, want 3", e, got)
+		}
+	}
+	if got := c.Frequency(server{"c", "us"}); got != 0 {
+		t.Errorf("Frequency(c): got %d, want 0", got)
+	}
+
+	// The first Element added with each key represents it.
+	if got, want := c.MinHittingSet(), [][]Element{{a1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MinHittingSet: got %v, want %v", got, want)
+	}
+	if got, want := c.Minimize(), [][]Subset{{"P"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Minimize: got %v, want %v", got, want)
+	}
+
+	// Clone and Reset preserve the key function.
+	d := c.Clone()
+	d.Reset()
+	d.Add("S", a2)
+	d.Add("T", a1)
+	if got := d.Frequency(a1); got != 2 {
+		t.Errorf("Frequency(%v) after Reset: got %d, want 2", a1, got)
+	}
+	if got := d.String(); !strings.Contains(got, "eu") || strings.Contains(got, "us") {
+		t.Errorf("String after Reset: got %q, want representative %v", got, a2)
+	}
+}
+
+func TestNewWithKeys(t *testing.T) {
+	lower := func(s Subset) interface{} { return strings.ToLower(s.(string)) }
+	c := NewWithKeys(lower, nil)
+	// Setting metadata does not add a Subset, so it does not choose the representative.
+	c.SetMeta("alpha", "meta")
+	c.AddWithCost("Alpha", 2, 1, 2)
+	c.Add("ALPHA", 3)
+	c.Add("Beta", 3)
+	c.AddWithCost("beta", 5, 4)
+
+	if got, want := c.String(), "Alpha: {1, 2, 3}\nBeta: {3, 4}"; got != want {
+		t.Errorf("String: got %q, want %q", got, want)
+	}
+	for s, want := range map[Subset]int{"alpha": 3, "BETA": 2, "gamma": 0} {
+		if got := c.Size(s); got != want {
+			t.Errorf("Size(%v): got %d, want %d", s, got, want)
+		}
+	}
+	if got := c.Meta("ALPHA"); got != "meta" {
+		t.Errorf("Meta(ALPHA): got %v, want meta", got)
+	}
+	if got := c.Cost("ALPHA"); got != 2 {
+		t.Errorf("Cost(ALPHA): got %v, want 2", got)
+	}
+	if got := c.Cost("Beta"); got != 5 {
+		t.Errorf("Cost(Beta): got %v, want 5", got)
+	}
+	if !c.IsCover([]Subset{"alpha", "beta"}) || c.IsCover([]Subset{"alpha"}) {
+		t.Errorf("IsCover: got wrong result for %v", c)
+	}
+	if got, want := c.Minimize(), [][]Subset{{"Alpha", "Beta"}}; len(got) != 1 || !allMatch(got, want) {
+		t.Errorf("Minimize: got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,110 @@
+package cover
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FromMinterms returns a Cover for minimizing a Boolean function of numVars variables
+// as a sum of products, using the Quine–McCluskey method to find its prime implicants.
+// The function is true for each input in minterms and unspecified for each input in dontcares.
+//
+// Each prime implicant is added as a Subset containing the minterms it includes, which are the Elements.
+// Don't-cares may be combined into prime implicants but are not themselves added as Elements,
+// so they need not be covered, and a prime implicant that includes only don't-cares is omitted.
+// Subsets are labeled by cube strings of length numVars, most significant variable first,
+// in which '0' and '1' denote a complemented and uncomplemented variable
+// and '-' denotes a variable that does not appear in the product; for example,
+// "0-1-" includes minterms 2, 3, 6, and 7. Elements are the uint values of the minterms.
+// Minimize then returns the minimal sums of products.
+//
+// FromMinterms panics if numVars is negative or greater than 32,
+// or if any minterm or don't-care is not less than 1<<numVars.
+func FromMinterms(numVars int, minterms, dontcares []uint) *Cover {
+	if numVars < 0 || numVars > 32 {
+		panic(fmt.Sprintf("cover: FromMinterms: invalid number of variables %d", numVars))
+	}
+	for _, m := range append(minterms[:len(minterms):len(minterms)], dontcares...) {
+		if m>>numVars != 0 {
+			panic(fmt.Sprintf("cover: FromMinterms: input %d out of range for %d variables", m, numVars))
+		}
+	}
+
+	c := New()
+	for _, p := range primeImplicants(numVars, minterms, dontcares) {
+		var es []Element
+		for _, m := range minterms {
+			if p.includes(m) {
+				es = append(es, m)
+			}
+		}
+		c.Add(p.cube(numVars), es...)
+	}
+	return c
+}
+
+// implicant is a product term of a Boolean function.
+// The bits set in mask denote the variables that do not appear in it,
+// and the other bits of value denote the values of those that do.
+// The bits of value set in mask are zero.
+type implicant struct {
+	value, mask uint
+}
+
+// includes reports whether p includes minterm m.
+func (p implicant) includes(m uint) bool { return m&^p.mask == p.value }
+
+// cube returns the cube string of p for a function of n variables.
+func (p implicant) cube(n int) string {
+	var b strings.Builder
+	for i := n - 1; i >= 0; i-- {
+		switch bit := uint(1) << i; {
+		case p.mask&bit != 0:
+			b.WriteByte('-')
+		case p.value&bit != 0:
+			b.WriteByte('1')
+		default:
+			b.WriteByte('0')
+		}
+	}
+	return b.String()
+}
+
+// primeImplicants returns the prime implicants of a Boolean function of n variables
+// that is true for minterms and unspecified for dontcares.
+// At each step, it combines each pair of implicants that differ in the value of a single variable
+// into one implicant without that variable; those that combine with no other are prime.
+func primeImplicants(n int, minterms, dontcares []uint) []implicant {
+	cur := make(map[implicant]struct{})
+	for _, m := range minterms {
+		cur[implicant{m, 0}] = struct{}{}
+	}
+	for _, m := range dontcares {
+		cur[implicant{m, 0}] = struct{}{}
+	}
+
+	var primes []implicant
+	for len(cur) > 0 {
+		next := make(map[implicant]struct{})
+		combined := make(map[implicant]bool)
+		for p := range cur {
+			for i := 0; i < n; i++ {
+				bit := uint(1) << i
+				if p.mask&bit != 0 {
+					continue
+				}
+				if _, ok := cur[implicant{p.value ^ bit, p.mask}]; ok {
+					next[implicant{p.value &^ bit, p.mask | bit}] = struct{}{}
+					combined[p] = true
+				}
+			}
+		}
+		for p := range cur {
+			if !combined[p] {
+				primes = append(primes, p)
+			}
+		}
+		cur = next
+	}
+	return primes
+}
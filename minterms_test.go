@@ -0,0 +1,98 @@
+package cover
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestFromMinterms(t *testing.T) {
+	// The seven-segment tests hold implicants of functions of 4 variables with no don't-cares,
+	// including every prime implicant that belongs to a minimal sum of products.
+	for _, name := range []string{
+		"seven-segment A",
+		"seven-segment B",
+		"seven-segment C",
+		"seven-segment D",
+		"seven-segment G",
+	} {
+		test := coverTests[name]
+		var minterms []uint
+		for _, e := range elements(test.c.in) {
+			minterms = append(minterms, uint(e.(int)))
+		}
+		c := FromMinterms(4, minterms, nil)
+		if got := c.Minimize(); len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("Minimize(FromMinterms(%v)): got %v, want %v", name, got, test.min)
+		}
+	}
+}
+
+func TestFromMintermsDontCares(t *testing.T) {
+	for _, test := range []struct {
+		n                   int
+		minterms, dontcares []uint
+		want                map[Subset][]uint
+	}{
+		{0, nil, nil, map[Subset][]uint{}},
+		{0, []uint{0}, nil, map[Subset][]uint{"": {0}}},
+		{2, []uint{0, 1, 2, 3}, nil, map[Subset][]uint{"--": {0, 1, 2, 3}}},
+		{2, []uint{1}, []uint{3}, map[Subset][]uint{"-1": {1}}},
+		// The prime implicant "1-" includes only don't-cares and is omitted.
+		{2, []uint{0}, []uint{2, 3}, map[Subset][]uint{"-0": {0}}},
+		{
+			3, []uint{1, 3, 7}, []uint{5},
+			map[Subset][]uint{"--1": {1, 3, 7}},
+		},
+	} {
+		c := FromMinterms(test.n, test.minterms, test.dontcares)
+		got := make(map[Subset][]uint)
+		for _, s := range subsets(c.in) {
+			for _, e := range adjToA(c.in, s) {
+				got[s] = append(got[s], e.(uint))
+			}
+			sort.Slice(got[s], func(i, j int) bool { return got[s][i] < got[s][j] })
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("FromMinterms(%d, %v, %v): got %v, want %v", test.n, test.minterms, test.dontcares, got, test.want)
+		}
+	}
+}
+
+func TestFromMintermsPanics(t *testing.T) {
+	for _, test := range []struct {
+		n                   int
+		minterms, dontcares []uint
+	}{
+		{-1, nil, nil},
+		{33, nil, nil},
+		{2, []uint{4}, nil},
+		{2, []uint{0}, []uint{7}},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("FromMinterms(%d, %v, %v): did not panic", test.n, test.minterms, test.dontcares)
+				}
+			}()
+			FromMinterms(test.n, test.minterms, test.dontcares)
+		}()
+	}
+}
+
+func TestImplicantCube(t *testing.T) {
+	for _, test := range []struct {
+		p    implicant
+		n    int
+		want string
+	}{
+		{implicant{0, 0}, 0, ""},
+		{implicant{2, 5}, 4, "0-1-"},
+		{implicant{5, 0}, 4, "0101"},
+		{implicant{0, 15}, 4, "----"},
+	} {
+		if got := test.p.cube(test.n); got != test.want {
+			t.Errorf("cube(%+v, %d): got %q, want %q", test.p, test.n, got, test.want)
+		}
+	}
+}
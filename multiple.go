@@ -0,0 +1,91 @@
+package cover
+
+// MinimizeMultiple returns all minimum-length combinations of Subsets such that
+// every Element is contained by at least k of them.
+// If k is 1, it returns the same result as Minimize. If k is not positive, the only such combination is empty.
+// If some Element is contained by fewer than k Subsets, no combination suffices and it returns nil.
+//
+// Generalizing Minimize's identification of essential Subsets, every Subset that contains
+// an Element contained by exactly k Subsets belongs to every returned combination.
+// Minimize's removal of dominated Subsets does not generalize, since a Subset may be needed
+// alongside one that dominates it, so for k greater than 1 the returned combinations
+// may contain dominated Subsets.
+func (c *Cover) MinimizeMultiple(k int) [][]Subset {
+	if k == 1 {
+		return c.Minimize()
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if k <= 0 {
+		return [][]Subset{{}}
+	}
+
+	ss := subsets(c.in)
+	sortBySprint(ss)
+	t := newTable(c.in, ss)
+	forced := make([]bool, len(ss))
+	for _, adj := range t.adj {
+		switch {
+		case len(adj) < k:
+			return nil
+		case len(adj) == k:
+			for _, i := range adj {
+				forced[i] = true
+			}
+		}
+	}
+
+	// ess holds the forced Subsets, and free holds the indices of the others.
+	var ess []Subset
+	var free []int
+	for i, s := range ss {
+		if forced[i] {
+			ess = append(ess, s)
+		} else {
+			free = append(free, i)
+		}
+	}
+
+	// need holds the number of free Subsets required to contain each Element,
+	// and elems holds the indices of the Elements of each free Subset.
+	need := make([]int, len(t.es))
+	for j, adj := range t.adj {
+		need[j] = k
+		for _, i := range adj {
+			if forced[i] {
+				need[j]--
+			}
+		}
+	}
+	elems := make([][]int, len(free))
+	for x, i := range free {
+		for j := t.cov[i].next(0); j >= 0; j = t.cov[i].next(j + 1) {
+			elems[x] = append(elems[x], j)
+		}
+	}
+
+	count := make([]int, len(t.es))
+	var covers [][]Subset
+	for w := 0; w <= len(free) && covers == nil; w++ {
+		combinations(len(free), w, func(idx []int) bool {
+			clear(count)
+			for _, x := range idx {
+				for _, j := range elems[x] {
+					count[j]++
+				}
+			}
+			for j, n := range need {
+				if count[j] < n {
+					return true
+				}
+			}
+			cs := append(make([]Subset, 0, len(ess)+w), ess...)
+			for _, x := range idx {
+				cs = append(cs, ss[free[x]])
+			}
+			covers = append(covers, cs)
+			return true
+		})
+	}
+	return covers
+}
@@ -0,0 +1,43 @@
+package cover
+
+import "testing"
+
+func TestMinimizeMultiple(t *testing.T) {
+	for name, test := range coverTests {
+		if got := test.c.Clone().MinimizeMultiple(1); len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("MinimizeMultiple(%v, 1): got %v, want %v", name, got, test.min)
+		}
+		if got, want := test.c.Clone().MinimizeMultiple(0), [][]Subset{{}}; !allMatch(got, want) || len(got) != 1 {
+			t.Errorf("MinimizeMultiple(%v, 0): got %v, want %v", name, got, want)
+		}
+	}
+
+	c := New()
+	c.Add("A", 1, 2)
+	c.Add("B", 1, 2)
+	c.Add("C", 2, 3)
+	c.Add("D", 3)
+	c.Add("E", 1)
+	for _, test := range []struct {
+		k    int
+		want [][]Subset
+	}{
+		{-1, [][]Subset{{}}},
+		// C and D are the only Subsets that contain 3.
+		{2, [][]Subset{{"A", "B", "C", "D"}, {"A", "C", "D", "E"}, {"B", "C", "D", "E"}}},
+		{3, nil},
+	} {
+		if got := c.MinimizeMultiple(test.k); len(got) != len(test.want) || !allMatch(got, test.want) {
+			t.Errorf("MinimizeMultiple(%d): got %v, want %v", test.k, got, test.want)
+		}
+	}
+
+	// The dominated Subset A is needed alongside B to contain 1 twice.
+	c = New()
+	c.Add("A", 1)
+	c.Add("B", 1, 2)
+	c.Add("C", 2)
+	if got, want := c.MinimizeMultiple(2), [][]Subset{{"A", "B", "C"}}; !allMatch(got, want) || len(got) != 1 {
+		t.Errorf("MinimizeMultiple(2): got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,111 @@
+package cover
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// ParseORLib reads a set cover problem in the format of Beasley's OR-Library
+// and returns a Cover of it with the costs it gives.
+// The format is a sequence of whitespace-separated integers: the number of rows m and columns n,
+// the cost of each column, and then for each row, the number of columns that cover it
+// followed by the 1-based indices of those columns.
+// Each column is added by AddWithCost as a Subset labeled by its int index,
+// and each row is an Element labeled by its int index, also 1-based.
+// A column that covers no rows contains no Elements and is not added.
+//
+// ParseORLib returns an error if the input ends early, if a number is malformed or out of range,
+// or if anything follows the last row.
+// Costs may be any non-negative decimal numbers.
+func ParseORLib(r io.Reader) (*Cover, error) {
+	p := &orlibParser{sc: bufio.NewScanner(r)}
+	p.sc.Split(bufio.ScanWords)
+
+	numRows, err := p.int("number of rows", 0, math.MaxInt)
+	if err != nil {
+		return nil, err
+	}
+	numCols, err := p.int("number of columns", 0, math.MaxInt)
+	if err != nil {
+		return nil, err
+	}
+	// numCols is not trusted to allocate costs in advance, since a malformed header may give any number.
+	// costs[j] holds the cost of column j; costs[0] is unused.
+	costs := []float64{0}
+	for j := 1; j <= numCols; j++ {
+		cost, err := p.cost(j)
+		if err != nil {
+			return nil, err
+		}
+		costs = append(costs, cost)
+	}
+
+	c := New()
+	for i := 1; i <= numRows; i++ {
+		k, err := p.int(fmt.Sprintf("number of columns covering row %d", i), 0, numCols)
+		if err != nil {
+			return nil, err
+		}
+		for ; k > 0; k-- {
+			j, err := p.int(fmt.Sprintf("column covering row %d", i), 1, numCols)
+			if err != nil {
+				return nil, err
+			}
+			c.AddWithCost(j, costs[j], i)
+		}
+	}
+
+	if p.sc.Scan() {
+		return nil, fmt.Errorf("cover: ORLib: unexpected %q after row %d", p.sc.Text(), numRows)
+	}
+	if err := p.sc.Err(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// orlibParser reads the whitespace-separated tokens of an OR-Library file.
+type orlibParser struct {
+	sc *bufio.Scanner
+}
+
+// next returns the next token, which is described by what.
+func (p *orlibParser) next(what string) (string, error) {
+	if !p.sc.Scan() {
+		if err := p.sc.Err(); err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("cover: ORLib: reading %s: %w", what, io.ErrUnexpectedEOF)
+	}
+	return p.sc.Text(), nil
+}
+
+// int returns the next token as an integer between min and max inclusive.
+func (p *orlibParser) int(what string, min, max int) (int, error) {
+	tok, err := p.next(what)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(tok)
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("cover: ORLib: invalid %s %q", what, tok)
+	}
+	return n, nil
+}
+
+// cost returns the next token as the cost of column j.
+func (p *orlibParser) cost(j int) (float64, error) {
+	what := fmt.Sprintf("cost of column %d", j)
+	tok, err := p.next(what)
+	if err != nil {
+		return 0, err
+	}
+	cost, err := strconv.ParseFloat(tok, 64)
+	if err != nil || !(cost >= 0) || math.IsInf(cost, 0) {
+		return 0, fmt.Errorf("cover: ORLib: invalid %s %q", what, tok)
+	}
+	return cost, nil
+}
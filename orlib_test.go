@@ -0,0 +1,77 @@
+package cover
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestParseORLib(t *testing.T) {
+	// 4 rows and 5 columns; column 5 covers no rows.
+	const data = `4 5
+ 1 2 3 1
+ 7
+2 1 2
+2 2 3
+1 3
+3 1 3 4
+`
+	c, err := ParseORLib(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseORLib: %v", err)
+	}
+	want := New()
+	want.AddWithCost(1, 1, 1, 4)
+	want.AddWithCost(2, 2, 1, 2)
+	want.AddWithCost(3, 3, 2, 3, 4)
+	want.AddWithCost(4, 1, 4)
+	if got, want := c.String(), want.String(); got != want {
+		t.Errorf("ParseORLib: got\n%v\nwant\n%v", got, want)
+	}
+	for s, want := range map[Subset]float64{1: 1, 2: 2, 3: 3, 4: 1} {
+		if got := c.Cost(s); got != want {
+			t.Errorf("ParseORLib: Cost(%v): got %v, want %v", s, got, want)
+		}
+	}
+	if got, want := c.MinSize(), 2; got != want {
+		t.Errorf("ParseORLib: MinSize: got %d, want %d", got, want)
+	}
+
+	if c, err := ParseORLib(strings.NewReader("0 0")); err != nil || c.NumSubsets() != 0 {
+		t.Errorf("ParseORLib(empty problem): got %v, %v", c, err)
+	}
+}
+
+func TestParseORLibError(t *testing.T) {
+	for _, test := range []struct {
+		data      string
+		truncated bool
+	}{
+		{"", true},
+		{"2", true},
+		{"2 2 1", true},
+		{"2 2 1 1 1 1", true},
+		{"2 2 1 1 1 1 1", true},
+		{"1 9223372036854775807 1 1", true},
+		{"9223372036854775807 1 1 1 1", true},
+		{"x 2", false},
+		{"-1 2", false},
+		{"2 2 1 x", false},
+		{"2 2 1 -1", false},
+		{"2 2 1 NaN", false},
+		{"1 2 1 1 3 1 2 1", false},
+		{"1 2 1 1 1 3", false},
+		{"1 2 1 1 1 0", false},
+		{"1 2 1 1 1 1 1", false},
+	} {
+		_, err := ParseORLib(strings.NewReader(test.data))
+		if err == nil {
+			t.Errorf("ParseORLib(%q): got nil error", test.data)
+			continue
+		}
+		if got := errors.Is(err, io.ErrUnexpectedEOF); got != test.truncated {
+			t.Errorf("ParseORLib(%q): got error %v, want truncated %v", test.data, err, test.truncated)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package cover
+
+import "github.com/dkmccandless/bipartite"
+
+// MinimizePartial returns all minimum-length combinations of Subsets that together contain at least k Elements.
+// If k is the number of Elements, it returns the same result as Minimize.
+// If k is greater, no combination suffices and it returns nil.
+//
+// Because Elements may be left uncovered, a Subset that is the only one to contain some Element
+// is not necessarily part of a solution, so Minimize's identification of essential Subsets does not apply.
+// The removal of dominated Subsets does: replacing a Subset with one whose Elements are a proper superset
+// never decreases the number of Elements covered. As with Minimize, no returned combination
+// contains a dominated Subset.
+func (c *Cover) MinimizePartial(k int) [][]Subset {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch n := c.in.NB(); {
+	case k <= 0:
+		return [][]Subset{{}}
+	case k == n:
+		return c.minimize()
+	case k > n:
+		return nil
+	}
+
+	p := &Cover{
+		in: c.in,
+		m:  bipartite.Copy(c.in),
+
+		essential: make(sset),
+	}
+	p.reduceS()
+
+	ss := subsets(p.m)
+	t := newTable(p.m, ss)
+	u := newBitset(len(t.es))
+	var covers [][]Subset
+	for w := 1; w <= len(ss) && covers == nil; w++ {
+		combinations(len(ss), w, func(idx []int) bool {
+			u.clear()
+			for _, i := range idx {
+				u.or(t.cov[i])
+			}
+			if u.count() >= k {
+				cs := make([]Subset, 0, w)
+				for _, i := range idx {
+					cs = append(cs, ss[i])
+				}
+				covers = append(covers, cs)
+			}
+			return true
+		})
+	}
+	return covers
+}
+
+// MaxCoverage returns all combinations of exactly k Subsets that together contain the greatest number of Elements,
+// and that number.
+// Unlike MinimizePartial, it considers every Subset, including dominated ones,
+// since a dominated Subset may belong to a best combination when the one that dominates it is already chosen.
+// If k is negative or greater than the number of Subsets, MaxCoverage returns nil and 0.
+func (c *Cover) MaxCoverage(k int) ([][]Subset, int) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	ss := subsets(c.in)
+	if k < 0 || k > len(ss) {
+		return nil, 0
+	}
+
+	t := newTable(c.in, ss)
+	u := newBitset(len(t.es))
+	var covers [][]Subset
+	max := -1
+	combinations(len(ss), k, func(idx []int) bool {
+		u.clear()
+		for _, i := range idx {
+			u.or(t.cov[i])
+		}
+		if n := u.count(); n >= max {
+			if n > max {
+				covers, max = nil, n
+			}
+			cs := make([]Subset, 0, k)
+			for _, i := range idx {
+				cs = append(cs, ss[i])
+			}
+			covers = append(covers, cs)
+		}
+		return true
+	})
+	return covers, max
+}
@@ -0,0 +1,65 @@
+package cover
+
+import "testing"
+
+func TestMinimizePartial(t *testing.T) {
+	for name, test := range coverTests {
+		n := test.c.in.NB()
+		if got := test.c.Clone().MinimizePartial(n); len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("MinimizePartial(%v, %d): got %v, want %v", name, n, got, test.min)
+		}
+		if got := test.c.Clone().MinimizePartial(n + 1); got != nil {
+			t.Errorf("MinimizePartial(%v, %d): got %v, want nil", name, n+1, got)
+		}
+		if got, want := test.c.Clone().MinimizePartial(0), [][]Subset{{}}; !allMatch(got, want) || len(got) != 1 {
+			t.Errorf("MinimizePartial(%v, 0): got %v, want %v", name, got, want)
+		}
+	}
+
+	for _, test := range []struct {
+		k    int
+		want [][]Subset
+	}{
+		{1, [][]Subset{{"A"}, {"B"}, {"C"}}},
+		{2, [][]Subset{{"A"}, {"B"}, {"C"}}},
+		{3, [][]Subset{{"A"}}},
+		{4, [][]Subset{{"A", "B"}, {"A", "C"}}},
+		{5, [][]Subset{{"A", "C"}}},
+	} {
+		c := New()
+		c.Add("A", 1, 2, 3)
+		c.Add("B", 3, 4)
+		c.Add("C", 4, 5)
+		c.Add("D", 5)
+		if got := c.MinimizePartial(test.k); len(got) != len(test.want) || !allMatch(got, test.want) {
+			t.Errorf("MinimizePartial(%d): got %v, want %v", test.k, got, test.want)
+		}
+	}
+}
+
+func TestMaxCoverage(t *testing.T) {
+	c := New()
+	c.Add("A", 1, 2, 3)
+	c.Add("B", 3, 4)
+	c.Add("C", 4, 5)
+	c.Add("D", 5)
+	c.Add("E", 1, 2)
+	for _, test := range []struct {
+		k     int
+		want  [][]Subset
+		count int
+	}{
+		{-1, nil, 0},
+		{0, [][]Subset{{}}, 0},
+		{1, [][]Subset{{"A"}}, 3},
+		{2, [][]Subset{{"A", "C"}}, 5},
+		{3, [][]Subset{{"A", "B", "C"}, {"A", "C", "D"}, {"A", "C", "E"}, {"A", "B", "D"}, {"B", "C", "E"}, {"B", "D", "E"}}, 5},
+		{5, [][]Subset{{"A", "B", "C", "D", "E"}}, 5},
+		{6, nil, 0},
+	} {
+		got, count := c.MaxCoverage(test.k)
+		if count != test.count || len(got) != len(test.want) || !allMatch(got, test.want) {
+			t.Errorf("MaxCoverage(%d): got %v, %d; want %v, %d", test.k, got, count, test.want, test.count)
+		}
+	}
+}
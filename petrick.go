@@ -0,0 +1,99 @@
+package cover
+
+import (
+	"math/bits"
+	"sort"
+)
+
+const (
+	// petrickSubsets is the greatest number of Subsets in a cyclic core that petrick will solve.
+	petrickSubsets = 24
+
+	// petrickTerms is the greatest number of terms that petrick will hold in its product of sums.
+	petrickTerms = 1 << 12
+)
+
+// petrick returns all minimum-length combinations of Subsets in c.m that cover every Element in c.m,
+// each appended to ess, using Petrick's method. It reports false if c.m is too large to solve this way.
+//
+// Petrick's method expresses the covering condition as a product over Elements of the sum of the Subsets
+// containing each one, and multiplies it out into a sum of products, applying the absorption law X + XY = X
+// after each multiplication so that only irredundant covering sets remain. The minimum covering sets
+// are the products with the fewest Subsets.
+func (c *Cover) petrick(ess []Subset) ([][]Subset, bool) {
+	ss := subsets(c.m)
+	if len(ss) > petrickSubsets {
+		return nil, false
+	}
+	t := newTable(c.m, ss)
+
+	// Each term is a product of Subsets, represented by a bitmask of their indices.
+	terms := []uint64{0}
+	for _, adj := range t.adj {
+		var sum uint64
+		for _, i := range adj {
+			sum |= 1 << i
+		}
+		var next []uint64
+		for _, term := range terms {
+			if term&sum != 0 {
+				// term already contains a Subset in sum, and absorbs the products with all others.
+				next = append(next, term)
+				continue
+			}
+			for i := range adj {
+				next = append(next, term|1<<adj[i])
+			}
+		}
+		if c.stats != nil {
+			c.stats.Combinations += len(next)
+		}
+		terms = absorb(next)
+		if len(terms) > petrickTerms {
+			return nil, false
+		}
+	}
+
+	min := bits.OnesCount64(terms[0])
+	var covers [][]Subset
+	for _, term := range terms {
+		if bits.OnesCount64(term) > min {
+			break
+		}
+		cs := append(make([]Subset, 0, len(ess)+min), ess...)
+		for ; term != 0; term &= term - 1 {
+			cs = append(cs, ss[bits.TrailingZeros64(term)])
+		}
+		covers = append(covers, cs)
+	}
+	if c.stats != nil {
+		c.stats.Petrick = true
+	}
+	return covers, true
+}
+
+// absorb returns the terms that do not contain all of the Subsets of any other term,
+// sorted in increasing order of their number of Subsets. Duplicate terms are returned once.
+// It reuses the memory of terms.
+func absorb(terms []uint64) []uint64 {
+	sort.Slice(terms, func(i, j int) bool {
+		if a, b := bits.OnesCount64(terms[i]), bits.OnesCount64(terms[j]); a != b {
+			return a < b
+		}
+		return terms[i] < terms[j]
+	})
+	kept := terms[:0]
+	for _, term := range terms {
+		ok := true
+		for _, k := range kept {
+			if term&k == k {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			kept = append(kept, term)
+		}
+	}
+	return kept
+}
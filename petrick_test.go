@@ -0,0 +1,50 @@
+package cover
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dkmccandless/bipartite"
+)
+
+func TestPetrick(t *testing.T) {
+	for name, test := range coverTests {
+		c, ess := simplifiedCopy(test.c)
+		if c.m.NB() == 0 {
+			continue
+		}
+		want, _ := c.branchAndBound(ess)
+		got, ok := c.petrick(ess)
+		if !ok || len(got) != len(want) || !allMatch(got, want) {
+			t.Errorf("petrick(%v): got %v, %v; want %v, true", name, got, ok, want)
+		}
+	}
+
+	// A cycle in which each Subset shares one Element with each of its neighbors
+	// has no dominated Subsets or Elements and no essential Subsets.
+	n := petrickSubsets + 1
+	c := New()
+	for i := 0; i < n; i++ {
+		c.Add(i, i, (i+1)%n)
+	}
+	c.m = bipartite.Copy(c.in)
+	c, ess := simplifiedCopy(c)
+	if got, ok := c.petrick(ess); ok {
+		t.Errorf("petrick(%d Subsets): got %v, true; want false", c.m.NA(), got)
+	}
+}
+
+func TestAbsorb(t *testing.T) {
+	for _, test := range []struct {
+		terms, want []uint64
+	}{
+		{[]uint64{0}, []uint64{0}},
+		{[]uint64{0b11, 0b1, 0b10}, []uint64{0b1, 0b10}},
+		{[]uint64{0b110, 0b011, 0b110, 0b111}, []uint64{0b011, 0b110}},
+		{[]uint64{0b1011, 0b0100, 0b0011, 0b1100}, []uint64{0b0100, 0b0011}},
+	} {
+		if got := absorb(append([]uint64(nil), test.terms...)); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("absorb(%b): got %b, want %b", test.terms, got, test.want)
+		}
+	}
+}
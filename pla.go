@@ -0,0 +1,185 @@
+package cover
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ParsePLA reads a single-output Boolean function in the Berkeley PLA format used by Espresso
+// and returns a Cover of its minterms.
+// Each cube whose output is 1 is added as a Subset, labeled by the cube's input part,
+// that contains the minterms it spans, as in FromMinterms.
+// Cubes whose output is 0, '-', or '~' are ignored.
+//
+// The .i directive, which gives the number of inputs, must precede the first cube.
+// The .o directive is optional, but if present must give one output.
+// If the .p directive is present, the number of cubes must equal the number of product terms it gives.
+// Reading stops at .e or .end. Other directives, comments beginning with '#', and blank lines are ignored.
+// ParsePLA returns an error identifying the line number of a malformed line.
+//
+// Since each cube contains every minterm it spans, the size of the Cover may grow exponentially
+// with the number of '-' characters in the cubes.
+func ParsePLA(r io.Reader) (*Cover, error) {
+	c := New()
+	numVars, numTerms, cubes := -1, -1, 0
+	sc := bufio.NewScanner(r)
+	var line int
+	for sc.Scan() {
+		line++
+		text, _, _ := strings.Cut(sc.Text(), "#")
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if dir := fields[0]; strings.HasPrefix(dir, ".") {
+			switch dir {
+			case ".i", ".o", ".p":
+				if len(fields) != 2 {
+					return nil, fmt.Errorf("cover: PLA line %d: %s directive takes 1 argument", line, dir)
+				}
+				n, err := strconv.Atoi(fields[1])
+				if err != nil || n < 0 {
+					return nil, fmt.Errorf("cover: PLA line %d: invalid %s argument %q", line, dir, fields[1])
+				}
+				switch dir {
+				case ".i":
+					if numVars >= 0 {
+						return nil, fmt.Errorf("cover: PLA line %d: duplicate .i directive", line)
+					}
+					if n > 32 {
+						return nil, fmt.Errorf("cover: PLA line %d: too many inputs (%d)", line, n)
+					}
+					numVars = n
+				case ".o":
+					if n != 1 {
+						return nil, fmt.Errorf("cover: PLA line %d: %d outputs; only 1 is supported", line, n)
+					}
+				case ".p":
+					numTerms = n
+				}
+			case ".e", ".end":
+				return c, checkTerms(line, numTerms, cubes)
+			}
+			continue
+		}
+
+		if numVars < 0 {
+			return nil, fmt.Errorf("cover: PLA line %d: cube precedes .i directive", line)
+		}
+		cube := strings.Join(fields, "")
+		if len(cube) != numVars+1 {
+			return nil, fmt.Errorf("cover: PLA line %d: cube %q has length %d, want %d", line, strings.TrimSpace(text), len(cube), numVars+1)
+		}
+		in, out := cube[:numVars], cube[numVars]
+		p, err := parseCube(in)
+		if err != nil {
+			return nil, fmt.Errorf("cover: PLA line %d: %v", line, err)
+		}
+		cubes++
+		switch out {
+		case '1':
+			c.Add(in, p.minterms()...)
+		case '0', '-', '~':
+		default:
+			return nil, fmt.Errorf("cover: PLA line %d: invalid output %q", line, out)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return c, checkTerms(line, numTerms, cubes)
+}
+
+// WritePLA writes the Subsets in cover to w in the Berkeley PLA format read by ParsePLA,
+// as a single-output Boolean function with one cube per Subset, in order.
+// Each Subset must have been added to c and be labeled by a cube string as described for FromMinterms,
+// and all of them must have the same number of variables.
+// If cover is empty, the number of variables is that of the Subsets added to c, if any.
+func (c *Cover) WritePLA(w io.Writer, cover []Subset) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	numVars := -1
+	for _, s := range cover {
+		if c.in.DegA(c.subset(s, false)) == 0 {
+			return fmt.Errorf("cover: WritePLA: Subset %v not in Cover", s)
+		}
+		cube, ok := s.(string)
+		if !ok {
+			return fmt.Errorf("cover: WritePLA: Subset %v is not a cube string", s)
+		}
+		if _, err := parseCube(cube); err != nil {
+			return fmt.Errorf("cover: WritePLA: %v", err)
+		}
+		switch {
+		case numVars < 0:
+			numVars = len(cube)
+		case len(cube) != numVars:
+			return fmt.Errorf("cover: WritePLA: cube %q has length %d, want %d", cube, len(cube), numVars)
+		}
+	}
+	if numVars < 0 {
+		numVars = 0
+		if ss := subsets(c.in); len(ss) > 0 {
+			if cube, ok := ss[0].(string); ok {
+				numVars = len(cube)
+			}
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, ".i %d\n.o 1\n.p %d\n", numVars, len(cover))
+	for _, s := range cover {
+		fmt.Fprintf(&b, "%s 1\n", s)
+	}
+	b.WriteString(".e\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// checkTerms returns an error if a .p directive gave numTerms product terms
+// and the number of cubes read through line is different.
+func checkTerms(line, numTerms, cubes int) error {
+	if numTerms >= 0 && numTerms != cubes {
+		return fmt.Errorf("cover: PLA line %d: read %d cubes, but .p directive gives %d", line, cubes, numTerms)
+	}
+	return nil
+}
+
+// parseCube returns the implicant denoted by the cube string s,
+// whose characters are '0', '1', or '-', most significant variable first.
+func parseCube(s string) (implicant, error) {
+	if len(s) > 32 {
+		return implicant{}, fmt.Errorf("cube %q has more than 32 variables", s)
+	}
+	var p implicant
+	for i := 0; i < len(s); i++ {
+		p.value <<= 1
+		p.mask <<= 1
+		switch s[i] {
+		case '0':
+		case '1':
+			p.value |= 1
+		case '-':
+			p.mask |= 1
+		default:
+			return implicant{}, fmt.Errorf("invalid character %q in cube %q", s[i], s)
+		}
+	}
+	return p, nil
+}
+
+// minterms returns the minterms that p includes, as Elements.
+func (p implicant) minterms() []Element {
+	var es []Element
+	for sub := p.mask; ; sub = (sub - 1) & p.mask {
+		es = append(es, p.value|sub)
+		if sub == 0 {
+			return es
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package cover
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestParsePLA(t *testing.T) {
+	for _, test := range []struct {
+		pla  string
+		want *Cover
+	}{
+		{"", New()},
+		{".i 2\n.o 1\n.p 0\n.e\n", New()},
+		{
+			`# seven-segment A
+.i 4
+.o 1
+.ilb a b c d
+.ob s
+.p 9
+0-1- 1
+01-1 1
+-0-0 1
+--10 1
+-11- 1
+100- 1
+1--0 1
+11-0 1
+0001 0
+.e
+ignored after .e
+`,
+			coverTests["seven-segment A"].c,
+		},
+		{
+			".i 3\n1-1  1 # comment\n\n0 0 0 1\n-00 -\n--0 ~\n",
+			&Cover{in: fromInputs(
+				input{"1-1", []Element{5, 7}},
+				input{"000", []Element{0}},
+			)},
+		},
+	} {
+		c, err := ParsePLA(strings.NewReader(test.pla))
+		if err != nil {
+			t.Errorf("ParsePLA(%q): %v", test.pla, err)
+			continue
+		}
+		if got, want := c.String(), test.want.String(); got != want {
+			t.Errorf("ParsePLA(%q): got\n%v\nwant\n%v", test.pla, got, want)
+		}
+	}
+}
+
+func TestParsePLAError(t *testing.T) {
+	for _, test := range []struct {
+		pla, err string
+	}{
+		{".i\n", "line 1: .i directive takes 1 argument"},
+		{".i x\n", `line 1: invalid .i argument "x"`},
+		{".i -1\n", `line 1: invalid .i argument "-1"`},
+		{".i 33\n", "line 1: too many inputs (33)"},
+		{".i 2\n.i 2\n", "line 2: duplicate .i directive"},
+		{".i 2\n.o 2\n", "line 2: 2 outputs; only 1 is supported"},
+		{"01 1\n", "line 1: cube precedes .i directive"},
+		{".i 2\n\n010 1\n", `line 3: cube "010 1" has length 4, want 3`},
+		{".i 2\n0x 1\n", `line 2: invalid character 'x' in cube "0x"`},
+		{".i 2\n01 2\n", "line 2: invalid output '2'"},
+		{".i 2\n.p 2\n01 1\n.e\n", "line 4: read 1 cubes, but .p directive gives 2"},
+		{".i 2\n.p 0\n01 1\n", "line 3: read 1 cubes, but .p directive gives 0"},
+	} {
+		_, err := ParsePLA(strings.NewReader(test.pla))
+		if err == nil || !strings.HasSuffix(err.Error(), test.err) {
+			t.Errorf("ParsePLA(%q): got error %v, want %q", test.pla, err, test.err)
+		}
+	}
+}
+
+func TestImplicantMinterms(t *testing.T) {
+	for _, test := range []struct {
+		cube string
+		want string
+	}{
+		{"", "[0]"},
+		{"0101", "[5]"},
+		{"0-1-", "[2 3 6 7]"},
+		{"--", "[0 1 2 3]"},
+	} {
+		p, err := parseCube(test.cube)
+		if err != nil {
+			t.Fatal(err)
+		}
+		es := p.minterms()
+		sortBySprint(es)
+		if got := fmt.Sprint(es); got != test.want {
+			t.Errorf("minterms(%q): got %v, want %v", test.cube, got, test.want)
+		}
+	}
+}
+
+func TestWritePLA(t *testing.T) {
+	c := coverTests["seven-segment A"].c
+	for _, test := range []struct {
+		cover []Subset
+		want  string
+	}{
+		{nil, ".i 4\n.o 1\n.p 0\n.e\n"},
+		{[]Subset{"0-1-", "01-1"}, ".i 4\n.o 1\n.p 2\n0-1- 1\n01-1 1\n.e\n"},
+	} {
+		var b strings.Builder
+		if err := c.WritePLA(&b, test.cover); err != nil {
+			t.Errorf("WritePLA(%v): %v", test.cover, err)
+			continue
+		}
+		if got := b.String(); got != test.want {
+			t.Errorf("WritePLA(%v): got %q, want %q", test.cover, got, test.want)
+		}
+	}
+
+	var b strings.Builder
+	if err := New().WritePLA(&b, nil); err != nil || b.String() != ".i 0\n.o 1\n.p 0\n.e\n" {
+		t.Errorf("WritePLA(empty Cover): got %q, %v", b.String(), err)
+	}
+}
+
+func TestWritePLAError(t *testing.T) {
+	c := New()
+	c.Add("01", 1)
+	c.Add("0x", 0)
+	c.Add("1-0", 4, 6)
+	c.Add(3, 3)
+	for _, cover := range [][]Subset{
+		{"11"},
+		{"0x"},
+		{3},
+		{"01", "1-0"},
+	} {
+		if err := c.WritePLA(io.Discard, cover); err == nil {
+			t.Errorf("WritePLA(%v): got nil error", cover)
+		}
+	}
+}
+
+func TestPLARoundTrip(t *testing.T) {
+	const pla = ".i 4\n0-1- 1\n01-1 1\n-0-0 1\n--10 1\n-11- 1\n100- 1\n1--0 1\n11-0 1\n"
+	write := func(pla string) string {
+		c, err := ParsePLA(strings.NewReader(pla))
+		if err != nil {
+			t.Fatal(err)
+		}
+		covers := c.Minimize()
+		if len(covers) != 1 {
+			t.Fatalf("Minimize(%q): got %d covers, want 1", pla, len(covers))
+		}
+		sortBySprint(covers[0])
+		var b strings.Builder
+		if err := c.WritePLA(&b, covers[0]); err != nil {
+			t.Fatal(err)
+		}
+		return b.String()
+	}
+	once := write(pla)
+	if twice := write(once); twice != once {
+		t.Errorf("WritePLA(ParsePLA(%q)): got %q, want %q", once, twice, once)
+	}
+}
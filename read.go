@@ -0,0 +1,33 @@
+package cover
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// Read returns a Cover of the Subsets and Elements described by the lines of r.
+// Each line holds whitespace-separated tokens: a Subset followed by the Elements it contains,
+// all of which are added as strings, as if by Add. Blank lines are ignored.
+// Read returns the first error encountered while reading r,
+// including bufio.ErrTooLong for a line longer than bufio.MaxScanTokenSize.
+func Read(r io.Reader) (*Cover, error) {
+	c := New()
+	sc := bufio.NewScanner(r)
+	var es []Element
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		es = es[:0]
+		for _, f := range fields[1:] {
+			es = append(es, f)
+		}
+		c.Add(fields[0], es...)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,42 @@
+package cover
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRead(t *testing.T) {
+	for _, test := range []struct {
+		text, want string
+	}{
+		{"", ""},
+		{"\n  \n", ""},
+		{"A x y\nB\n\n\tC  y z \nA z", "A: {x, y, z}\nC: {y, z}"},
+		{"1 2 3", "1: {2, 3}"},
+	} {
+		c, err := Read(strings.NewReader(test.text))
+		if err != nil {
+			t.Errorf("Read(%q): %v", test.text, err)
+			continue
+		}
+		if got := c.String(); got != test.want {
+			t.Errorf("Read(%q): got %q, want %q", test.text, got, test.want)
+		}
+	}
+
+	// Subsets and Elements are strings.
+	c, err := Read(strings.NewReader("1 2 3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Size("1") != 2 || c.Size(1) != 0 || c.Frequency("2") != 1 {
+		t.Errorf("Read: got %#v, want string Subsets and Elements", c)
+	}
+
+	long := "A " + strings.Repeat("x", bufio.MaxScanTokenSize)
+	if _, err := Read(strings.NewReader(long)); !errors.Is(err, bufio.ErrTooLong) {
+		t.Errorf("Read(long line): got error %v, want %v", err, bufio.ErrTooLong)
+	}
+}
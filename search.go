@@ -0,0 +1,220 @@
+package cover
+
+import (
+	"context"
+	"math/bits"
+)
+
+// combinations calls f with the indices of each combination of w of the integers from 0 to n-1,
+// in increasing order, until f returns false. f must not retain the slice, which is reused between calls.
+// For n up to 64, combinations are encoded as bitmasks and enumerated with Gosper's hack,
+// which visits exactly the C(n, w) combinations; otherwise they are generated with nextPerm.
+func combinations(n, w int, f func(idx []int) bool) {
+	if w < 0 || w > n {
+		return
+	}
+	idx := make([]int, 0, w)
+	if w == 0 {
+		f(idx)
+		return
+	}
+
+	if n > 64 {
+		b := make([]bool, n)
+		for i := 0; i < w; i++ {
+			b[i] = true
+		}
+		for {
+			idx = idx[:0]
+			for i := range b {
+				if b[i] {
+					idx = append(idx, i)
+				}
+			}
+			if !f(idx) || !nextPerm(b) {
+				return
+			}
+		}
+	}
+
+	// Enumerate the n-bit integers with w bits set in increasing order using Gosper's hack.
+	for x := uint64(1)<<w - 1; ; {
+		idx = idx[:0]
+		for y := x; y != 0; y &= y - 1 {
+			idx = append(idx, bits.TrailingZeros64(y))
+		}
+		if !f(idx) {
+			return
+		}
+		// Add the lowest set bit to carry into the lowest unset bit above the lowest run of set bits,
+		// then restore the rest of that run at the bottom.
+		u := x & -x
+		v := x + u
+		if v == 0 {
+			return
+		}
+		x = v + (x^v)/u>>2
+		if n < 64 && x>>n != 0 {
+			return
+		}
+	}
+}
+
+// branchAndBound returns all minimum-length combinations of Subsets in c.m that cover every Element in c.m,
+// each appended to ess, and the number of search nodes it visited.
+func (c *Cover) branchAndBound(ess []Subset) ([][]Subset, int) {
+	b := newBnb(newTable(c.m, subsets(c.m)), ess)
+	b.search()
+	return b.covers, b.nodes
+}
+
+// width returns the length of the shortest combinations of Subsets in c.m that cover every Element in c.m.
+func (c *Cover) width() int {
+	b := newBnb(newTable(c.m, subsets(c.m)), nil)
+	b.one = true
+	b.search()
+	return b.best
+}
+
+// newBnb returns a bnb to search for covering sets of the Elements in t, each appended to ess.
+func newBnb(t *table, ess []Subset) *bnb {
+	return &bnb{
+		t:   t,
+		ess: ess,
+
+		covered:  make([]int, len(t.es)),
+		excluded: make([]bool, len(t.ss)),
+
+		// Choosing every Subset covers every Element, so no covering set of interest is longer.
+		best: len(t.ss),
+	}
+}
+
+// bnb holds the state of a branch and bound search for minimum covering sets.
+type bnb struct {
+	// t holds the Subsets to choose from and the Elements to cover.
+	t *table
+
+	// ess holds the Subsets to include in every covering set in addition to those chosen from t.
+	ess []Subset
+
+	// chosen holds the indices of the Subsets chosen along the current branch.
+	chosen []int
+
+	// covered records the number of chosen Subsets that contain each Element.
+	covered []int
+
+	// excluded records the Subsets that may not be chosen along the current branch
+	// because every covering set containing them has already been explored.
+	excluded []bool
+
+	// best is the length of the shortest covering set found so far.
+	best int
+
+	// one indicates that the search is for a single shortest covering set,
+	// so that branches that cannot improve on best may be pruned.
+	one bool
+
+	// covers holds the covering sets of length best found so far.
+	covers [][]Subset
+
+	// nodes counts the calls to search.
+	nodes int
+
+	// ctx, if not nil, is checked periodically, and the search stops if it is done.
+	ctx context.Context
+
+	// maxNodes, if positive, is the greatest number of nodes the search may visit.
+	maxNodes int
+
+	// err holds the reason the search stopped early: the error of ctx once it is done,
+	// or errBudget once the search has visited maxNodes nodes.
+	err error
+}
+
+// ctxCheckInterval is the number of nodes a bnb visits between checks of its context.
+const ctxCheckInterval = 1 << 10
+
+// search explores all extensions of b.chosen that might cover every Element
+// using no more Subsets than the shortest covering set found so far.
+//
+// It branches on the uncovered Element contained by the fewest Subsets that may still be chosen:
+// some Subset containing it must be chosen, and after each one has been tried it is excluded from
+// the remaining branches so that every covering set is found exactly once.
+func (b *bnb) search() {
+	b.nodes++
+	if b.ctx != nil && b.err == nil && b.nodes%ctxCheckInterval == 0 {
+		b.err = b.ctx.Err()
+	}
+	if b.maxNodes > 0 && b.err == nil && b.nodes > b.maxNodes {
+		b.err = errBudget
+	}
+	if b.err != nil {
+		return
+	}
+	e, n := -1, 0
+	for f, adj := range b.t.adj {
+		if b.covered[f] > 0 {
+			continue
+		}
+		var k int
+		for _, i := range adj {
+			if !b.excluded[i] {
+				k++
+			}
+		}
+		if e == -1 || k < n {
+			e, n = f, k
+		}
+	}
+
+	if e == -1 {
+		// Every Element is covered.
+		if len(b.chosen) < b.best {
+			b.best, b.covers = len(b.chosen), nil
+		}
+		cs := append(make([]Subset, 0, len(b.ess)+len(b.chosen)), b.ess...)
+		for _, i := range b.chosen {
+			cs = append(cs, b.t.ss[i])
+		}
+		b.covers = append(b.covers, cs)
+		return
+	}
+	if len(b.chosen) >= b.best {
+		// At least one more Subset is required, so no extension can be as short as the best covering set.
+		return
+	}
+	if b.one && b.covers != nil && len(b.chosen)+1 == b.best {
+		// No extension can be shorter than the best covering set.
+		return
+	}
+
+	var excluded []int
+	for _, i := range b.t.adj[e] {
+		if b.excluded[i] {
+			continue
+		}
+		b.choose(i, 1)
+		b.search()
+		b.choose(i, -1)
+
+		b.excluded[i] = true
+		excluded = append(excluded, i)
+	}
+	for _, i := range excluded {
+		b.excluded[i] = false
+	}
+}
+
+// choose adds the Subset with index i to b.chosen if d is 1, or removes it from the end if d is -1.
+func (b *bnb) choose(i, d int) {
+	if d > 0 {
+		b.chosen = append(b.chosen, i)
+	} else {
+		b.chosen = b.chosen[:len(b.chosen)-1]
+	}
+	cov := b.t.cov[i]
+	for e := cov.next(0); e >= 0; e = cov.next(e + 1) {
+		b.covered[e] += d
+	}
+}
@@ -0,0 +1,212 @@
+package cover
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// simplifiedCopy returns a simplified copy of c and its essential Subsets.
+func simplifiedCopy(c *Cover) (*Cover, []Subset) {
+	s := c.Clone()
+	s.simplify()
+	var ess []Subset
+	for e := range s.essential {
+		ess = append(ess, e)
+	}
+	return s, ess
+}
+
+// bruteForce returns all minimum-length combinations of Subsets in c.m that cover every Element in c.m,
+// each appended to ess, and the number of combinations it evaluated.
+// It searches all Subset unions of length 1, then 2, and so on until covering sets are found.
+func (c *Cover) bruteForce(ess []Subset) ([][]Subset, int) {
+	var covers [][]Subset
+	var n int
+	ss := subsets(c.m)
+	// Sort the Subsets to search in order of coverage, starting with the largest.
+	sort.Slice(ss, func(i, j int) bool { return c.m.DegA(ss[i]) > c.m.DegA(ss[j]) })
+	t := newTable(c.m, ss)
+	u := newBitset(len(t.es))
+
+	for w := 1; w <= len(ss) && len(covers) == 0; w++ {
+		combinations(len(ss), w, func(idx []int) bool {
+			n++
+			// Accumulate the Elements of the Subsets in the combination.
+			u.clear()
+			for _, i := range idx {
+				u.or(t.cov[i])
+			}
+
+			if u.equal(t.all) {
+				// All Elements are covered by at least one of the Subsets in the combination.
+				cs := append(make([]Subset, 0, len(ess)+w), ess...)
+				for _, i := range idx {
+					cs = append(cs, ss[i])
+				}
+				covers = append(covers, cs)
+			}
+			return true
+		})
+	}
+
+	return covers, n
+}
+
+func TestBranchAndBound(t *testing.T) {
+	for name, test := range coverTests {
+		c, ess := simplifiedCopy(test.c)
+		if c.m.NB() == 0 {
+			continue
+		}
+		want, _ := c.bruteForce(ess)
+		if got, _ := c.branchAndBound(ess); len(got) != len(want) || !allMatch(got, want) {
+			t.Errorf("branchAndBound(%v): got %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestWidth(t *testing.T) {
+	for name, test := range coverTests {
+		c, ess := simplifiedCopy(test.c)
+		if got, want := c.width()+len(ess), len(test.min[0]); c.m.NB() > 0 && got != want {
+			t.Errorf("width(%v): got %d, want %d", name, got-len(ess), want-len(ess))
+		}
+	}
+}
+
+func BenchmarkSearch(b *testing.B) {
+	for name, test := range coverTests {
+		if !strings.HasPrefix(name, "seven-segment") {
+			continue
+		}
+		c, ess := simplifiedCopy(test.c)
+		if c.m.NB() == 0 {
+			continue
+		}
+		for _, search := range []struct {
+			name string
+			f    func([]Subset) ([][]Subset, int)
+		}{
+			{"bruteForce", c.bruteForce},
+			{"branchAndBound", c.branchAndBound},
+		} {
+			b.Run(name+"/"+search.name, func(b *testing.B) {
+				var n int
+				for i := 0; i < b.N; i++ {
+					_, n = search.f(ess)
+				}
+				b.ReportMetric(float64(n), "nodes/op")
+			})
+		}
+	}
+}
+
+// noChecks disables the checks enabled by checkReductions for the rest of b,
+// so that they do not count toward its time.
+func noChecks(b *testing.B) {
+	checkReductions = false
+	b.Cleanup(func() { checkReductions = true })
+}
+
+// benchSizes holds the parameters of Generate for benchmarks at several sizes.
+var benchSizes = []struct {
+	n, m int
+	p    float64
+}{
+	{20, 20, 0.2},
+	{30, 40, 0.15},
+	{40, 60, 0.1},
+	{60, 100, 0.08},
+}
+
+func BenchmarkMinimize(b *testing.B) {
+	noChecks(b)
+	for _, size := range benchSizes {
+		c := Generate(1, size.n, size.m, size.p)
+		b.Run(fmt.Sprintf("%dx%d", size.n, size.m), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				c.Minimize()
+			}
+		})
+	}
+}
+
+func BenchmarkSimplify(b *testing.B) {
+	noChecks(b)
+	for _, size := range benchSizes {
+		c := Generate(1, size.n, size.m, size.p)
+		b.Run(fmt.Sprintf("%dx%d", size.n, size.m), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				c.simplified()
+			}
+		})
+	}
+}
+
+func BenchmarkBruteForce(b *testing.B) {
+	noChecks(b)
+	for _, size := range benchSizes[:2] {
+		s, _ := Generate(1, size.n, size.m, size.p).simplified()
+		ess := s.Essential()
+		b.Run(fmt.Sprintf("%dx%d", size.n, size.m), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				s.bruteForce(ess)
+			}
+		})
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	for _, test := range []struct{ n, w int }{
+		{0, 0}, {1, 0}, {1, 1}, {2, 1}, {4, 2}, {5, 3}, {6, 6}, {10, 4},
+		{64, 1}, {64, 63}, {64, 64}, {66, 2},
+	} {
+		// want holds the combinations encoded by the permutations generated by nextPerm.
+		want := make(map[string]bool)
+		b := make([]bool, test.n)
+		for i := 0; i < test.w; i++ {
+			b[i] = true
+		}
+		for {
+			want[fmt.Sprint(b)] = true
+			if !nextPerm(b) {
+				break
+			}
+		}
+
+		got := make(map[string]bool)
+		combinations(test.n, test.w, func(idx []int) bool {
+			if len(idx) != test.w || !sort.IntsAreSorted(idx) {
+				t.Errorf("combinations(%d, %d): got %v", test.n, test.w, idx)
+			}
+			b := make([]bool, test.n)
+			for _, i := range idx {
+				b[i] = true
+			}
+			if k := fmt.Sprint(b); got[k] {
+				t.Errorf("combinations(%d, %d): got %v twice", test.n, test.w, idx)
+			} else {
+				got[k] = true
+			}
+			return true
+		})
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("combinations(%d, %d): got %d combinations, want %d", test.n, test.w, len(got), len(want))
+		}
+	}
+
+	var n int
+	combinations(10, 3, func([]int) bool {
+		n++
+		return n < 5
+	})
+	if n != 5 {
+		t.Errorf("combinations: got %d calls after returning false, want 5", n)
+	}
+}
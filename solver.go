@@ -0,0 +1,46 @@
+package cover
+
+import "github.com/dkmccandless/bipartite"
+
+// A Solver finds minimum covering sets like Minimize, retaining the storage it uses for simplification
+// between calls so that solving many Covers in succession allocates less.
+// The zero value is ready to use. A Solver must not be used by multiple goroutines simultaneously.
+type Solver struct {
+	// m and essential hold the working state of the Cover being solved.
+	m         *bipartite.Graph
+	essential sset
+
+	// tab holds the table used to remove dominated Subsets.
+	tab table
+}
+
+// Minimize returns the same result as c.Minimize.
+// Unlike c.Minimize, it does not modify c, so the Subsets it finds to be essential
+// are not reported by c.Essential.
+func (sv *Solver) Minimize(c *Cover) [][]Subset {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if sv.m == nil {
+		sv.m = bipartite.New()
+		sv.essential = make(sset)
+	}
+	empty(sv.m)
+	for _, s := range c.in.As() {
+		for _, e := range c.in.AdjToA(s) {
+			sv.m.Add(s, e)
+		}
+	}
+	clear(sv.essential)
+
+	w := &Cover{
+		in: c.in,
+		m:  sv.m,
+
+		essential: sv.essential,
+
+		tab: &sv.tab,
+
+		dontCare: c.dontCare,
+	}
+	return w.solve()
+}
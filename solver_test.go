@@ -0,0 +1,50 @@
+package cover
+
+import "testing"
+
+func TestSolver(t *testing.T) {
+	var sv Solver
+	for i := 0; i < 3; i++ {
+		for name, test := range coverTests {
+			want := test.c.Clone()
+			if got := sv.Minimize(test.c); len(got) != len(test.min) || !allMatch(got, test.min) {
+				t.Errorf("Solver.Minimize(%v): got %v, want %v", name, got, test.min)
+			}
+			if !test.c.Equal(want) || len(test.c.essential) != len(want.essential) {
+				t.Errorf("Solver.Minimize(%v): modified Cover", name)
+			}
+		}
+	}
+}
+
+// smallCovers returns n small random Covers.
+func smallCovers(n int) []*Cover {
+	cs := make([]*Cover, n)
+	for i := range cs {
+		cs[i] = Generate(int64(i), 10, 12, 0.3)
+	}
+	return cs
+}
+
+func BenchmarkSolverMinimize(b *testing.B) {
+	noChecks(b)
+	cs := smallCovers(100)
+	var sv Solver
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, c := range cs {
+			sv.Minimize(c)
+		}
+	}
+}
+
+func BenchmarkCoverMinimize(b *testing.B) {
+	noChecks(b)
+	cs := smallCovers(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, c := range cs {
+			c.Minimize()
+		}
+	}
+}
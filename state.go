@@ -0,0 +1,36 @@
+package cover
+
+import "github.com/dkmccandless/bipartite"
+
+// A State records the state of a call to Minimize: the Subsets and Elements that remained
+// to be simplified or searched, and the Subsets found to be essential.
+// The zero State is that of a Cover on which Minimize has not been called.
+type State struct {
+	m         *bipartite.Graph
+	essential sset
+}
+
+// SaveState returns a copy of the state that Minimize left in c, which Essential reports,
+// for later use by RestoreState. The State shares no memory with c.
+func (c *Cover) SaveState() State {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return State{
+		m:         bipartite.Copy(c.m),
+		essential: c.essential.copy(),
+	}
+}
+
+// RestoreState replaces the state that Minimize left in c with a copy of st,
+// which need not have been saved from c. The Subsets and Elements added to c are unchanged.
+// st may be restored any number of times.
+func (c *Cover) RestoreState(st State) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if st.m == nil {
+		c.m = bipartite.New()
+	} else {
+		c.m = bipartite.Copy(st.m)
+	}
+	c.essential = st.essential.copy()
+}
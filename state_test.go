@@ -0,0 +1,38 @@
+package cover
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dkmccandless/bipartite"
+)
+
+func TestState(t *testing.T) {
+	for name, test := range coverTests {
+		c := test.c.Clone()
+		c.m = bipartite.Copy(c.in)
+		c.reduceS()
+		st := c.SaveState()
+		want := c.Clone()
+
+		c.reduceE()
+		c.RestoreState(st)
+		if !reflect.DeepEqual(c, want) {
+			t.Errorf("RestoreState(%v): got %+v, want %+v", name, c, want)
+		}
+
+		// Changes to c after RestoreState do not affect st.
+		c.reduceE()
+		c.RestoreState(st)
+		if !reflect.DeepEqual(c, want) {
+			t.Errorf("RestoreState(%v) again: got %+v, want %+v", name, c, want)
+		}
+	}
+
+	c := coverTests["B contains A"].c.Clone()
+	c.Minimize()
+	c.RestoreState(State{})
+	if ess := c.Essential(); ess != nil || c.m.NA() != 0 || c.m.NB() != 0 {
+		t.Errorf("RestoreState(zero): got Essential %v and %d Subsets and %d Elements, want none", ess, c.m.NA(), c.m.NB())
+	}
+}
@@ -0,0 +1,69 @@
+package cover
+
+import (
+	"fmt"
+	"io"
+)
+
+// Stats describes the work done by a call to Minimize.
+type Stats struct {
+	// Dominated is the number of dominated Subsets removed during simplification.
+	Dominated int
+
+	// DominatedElements is the number of dominated Elements removed during simplification.
+	DominatedElements int
+
+	// Essential is the number of essential Subsets found during simplification.
+	Essential int
+
+	// Rounds is the number of times simplification repeated its search for essential Subsets
+	// after removing dominated Subsets.
+	Rounds int
+
+	// CoreSubsets and CoreElements are the numbers of Subsets and Elements
+	// that remained to be searched after simplification.
+	CoreSubsets, CoreElements int
+
+	// Petrick reports whether the search used Petrick's method.
+	// Otherwise, if the core is not empty, it used branch and bound.
+	Petrick bool
+
+	// Combinations is the number of combinations of Subsets of the core considered by the search:
+	// the products formed by Petrick's method before absorption, plus the nodes visited by branch and bound
+	// if Petrick's method found the core too large to solve.
+	Combinations int
+}
+
+// MinimizeStats returns the same result as Minimize, which it calls, and statistics about its work.
+func (c *Cover) MinimizeStats() ([][]Subset, Stats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var st Stats
+	c.stats = &st
+	defer func() { c.stats = nil }()
+	covers := c.minimize()
+	return covers, st
+}
+
+// MinimizeTrace returns the same result as Minimize, which it calls, and writes a description of its work to w,
+// one step per line. It reports each dominated Subset removed during simplification ("s dominated by d"),
+// each essential Subset found and the Elements not yet covered that it covers ("s essential, covers {e1, e2}"),
+// and each dominated Element removed ("g implied by f", as every Subset that contains f contains g),
+// followed by either the Subsets and Elements of the cyclic core that remain to be searched
+// or a statement that the essential Subsets cover every Element.
+// Subsets and Elements are considered in order of their fmt.Sprint representations,
+// so the description of a given Cover is always the same. Errors writing to w are ignored.
+func (c *Cover) MinimizeTrace(w io.Writer) [][]Subset {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.trace = w
+	defer func() { c.trace = nil }()
+	return c.minimize()
+}
+
+// tracef writes a line to c.trace, if it is not nil, formatted as by fmt.Printf.
+func (c *Cover) tracef(format string, args ...interface{}) {
+	if c.trace != nil {
+		fmt.Fprintf(c.trace, format+"\n", args...)
+	}
+}
@@ -0,0 +1,93 @@
+package cover
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMinimizeStats(t *testing.T) {
+	for name, test := range coverTests {
+		c := test.c.Clone()
+		got, st := c.MinimizeStats()
+		if len(got) != len(test.min) || !allMatch(got, test.min) {
+			t.Errorf("MinimizeStats(%v): got %v, want %v", name, got, test.min)
+		}
+		if st.Essential != len(test.sim.essential) || st.CoreSubsets != test.sim.m.NA() || st.CoreElements != test.sim.m.NB() {
+			t.Errorf("MinimizeStats(%v): got %+v, want %d essential and a core of %d Subsets and %d Elements",
+				name, st, len(test.sim.essential), test.sim.m.NA(), test.sim.m.NB())
+		}
+		if test.simok && (st.Petrick || st.Combinations != 0) {
+			t.Errorf("MinimizeStats(%v): got %+v, want no search", name, st)
+		}
+		// Unless a Subset contains every Element, a Cover whose simplification is not unique requires a search.
+		if !test.simok && st.Rounds > 0 && st.Combinations == 0 {
+			t.Errorf("MinimizeStats(%v): got %+v, want a search", name, st)
+		}
+		if c.stats != nil {
+			t.Errorf("MinimizeStats(%v): stats retained", name)
+		}
+	}
+
+	if _, st := coverTests["seven-segment A"].c.Clone().MinimizeStats(); !reflect.DeepEqual(st, Stats{Dominated: 1, Essential: 6, Rounds: 1}) {
+		t.Errorf("MinimizeStats(seven-segment A): got %+v", st)
+	}
+	// B contains every Element, so Minimize performs no simplification.
+	if _, st := coverTests["B contains A"].c.Clone().MinimizeStats(); !reflect.DeepEqual(st, Stats{Essential: 1}) {
+		t.Errorf("MinimizeStats(B contains A): got %+v", st)
+	}
+
+	// A cyclic core too large for Petrick's method is searched by branch and bound.
+	c := Generate(1, petrickSubsets+16, 20, 0.2)
+	covers, st := c.MinimizeStats()
+	if st.CoreSubsets <= petrickSubsets {
+		t.Fatalf("MinimizeStats(random): got %+v, want more than %d core Subsets", st, petrickSubsets)
+	}
+	if st.Petrick || st.Combinations == 0 {
+		t.Errorf("MinimizeStats(random): got %+v, want branch and bound", st)
+	}
+	for _, cs := range covers {
+		if !c.IsCover(cs) {
+			t.Errorf("MinimizeStats(random): got non-cover %v", cs)
+		}
+	}
+}
+
+func TestMinimizeTrace(t *testing.T) {
+	for name, want := range map[string]string{
+		"B contains A": "B contains every Element\n",
+		"seven-segment A": `11-0 dominated by 1--0
+-0-0 essential, covers {0, 10, 2, 8}
+1--0 essential, covers {12, 14}
+-11- essential, covers {15, 6, 7}
+0-1- essential, covers {3}
+01-1 essential, covers {5}
+100- essential, covers {9}
+essential Subsets cover every Element
+`,
+		"seven-segment B": `-0-0 essential, covers {0, 10, 2, 8}
+1-01 essential, covers {13, 9}
+0-00 essential, covers {4}
+0-11 essential, covers {3, 7}
+searching cyclic core of 2 Subsets and 1 Elements:
+	-00-: {1}
+	00--: {1}
+`,
+	} {
+		test := coverTests[name]
+		for i := 0; i < 10; i++ {
+			var b strings.Builder
+			c := test.c.Clone()
+			got := c.MinimizeTrace(&b)
+			if len(got) != len(test.min) || !allMatch(got, test.min) {
+				t.Errorf("MinimizeTrace(%v): got %v, want %v", name, got, test.min)
+			}
+			if b.String() != want {
+				t.Errorf("MinimizeTrace(%v): got trace\n%s\nwant\n%s", name, b.String(), want)
+			}
+			if c.trace != nil {
+				t.Errorf("MinimizeTrace(%v): trace retained", name)
+			}
+		}
+	}
+}